@@ -24,4 +24,5 @@ func Execute() {
 func init() {
 	rootCmd.AddCommand(simulateCmd)
 	rootCmd.AddCommand(replayCmd)
+	rootCmd.AddCommand(validateCmd)
 }
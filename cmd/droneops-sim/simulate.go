@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"net/http"
 	"os"
@@ -15,6 +16,7 @@ import (
 	"droneops-sim/internal/admin"
 	"droneops-sim/internal/config"
 	"droneops-sim/internal/logging"
+	"droneops-sim/internal/scenario"
 	"droneops-sim/internal/sim"
 	"droneops-sim/internal/telemetry"
 )
@@ -25,6 +27,7 @@ var (
 	simSchemaPath        string
 	simTick              time.Duration
 	simLogFile           string
+	simScenario          string
 	simEnableDetections  bool = true
 	simEnableSwarmEvents bool = true
 	simEnableMovement    bool = true
@@ -41,6 +44,17 @@ var simulateCmd = &cobra.Command{
 			return err
 		}
 
+		var sc *scenario.Scenario
+		if simScenario != "" {
+			sc, err = scenario.Resolve(simScenario)
+			if err != nil {
+				return err
+			}
+			if issues := sc.Lint(); len(issues) > 0 {
+				return fmt.Errorf("invalid scenario %s: %s (run validate --scenario for details)", simScenario, issues[0])
+			}
+		}
+
 		if v := os.Getenv("ENABLE_DETECTIONS"); v != "" {
 			if b, err := strconv.ParseBool(v); err == nil {
 				simEnableDetections = b
@@ -67,7 +81,7 @@ var simulateCmd = &cobra.Command{
 		cfg.Telemetry.MovementMetrics = &simEnableMovement
 		cfg.Telemetry.SimulationState = &simEnableState
 
-		writer, detectWriter, missionWriter, cleanup, err := newWriters(cfg, simPrintOnly, simLogFile, simEnableDetections, simEnableSwarmEvents, simEnableState)
+		writer, detectWriter, missionWriter, cleanup, err := newWriters(cfg, simPrintOnly, simLogFile, simEnableDetections, simEnableSwarmEvents, simEnableState, sc != nil)
 		if err != nil {
 			return err
 		}
@@ -83,12 +97,7 @@ var simulateCmd = &cobra.Command{
 					Name:        m.Name,
 					Objective:   m.Objective,
 					Description: m.Description,
-					Region: telemetry.Region{
-						Name:      m.Region.Name,
-						CenterLat: m.Region.CenterLat,
-						CenterLon: m.Region.CenterLon,
-						RadiusKM:  m.Region.RadiusKM,
-					},
+					Region:      m.Region.Telemetry(),
 				}
 				if err := missionWriter.WriteMission(row); err != nil {
 					return err
@@ -125,6 +134,9 @@ var simulateCmd = &cobra.Command{
 		if up, ok := writer.(sim.EnemyStatusUpdater); ok {
 			up.SetStatusUpdater(simulator.UpdateEnemyStatus)
 		}
+		if sc != nil {
+			simulator.SetScenario(sc)
+		}
 
 		srv := admin.NewServer(simulator)
 		if aw, ok := writer.(sim.AdminStatusWriter); ok {
@@ -157,6 +169,7 @@ func init() {
 	simulateCmd.Flags().StringVar(&simSchemaPath, "schema", "schemas/simulation.cue", "Path to CUE schema file")
 	simulateCmd.Flags().DurationVar(&simTick, "tick", time.Second, "Telemetry tick interval (e.g. 500ms, 2s)")
 	simulateCmd.Flags().StringVar(&simLogFile, "log-file", "", "Path to export telemetry/detection logs (JSONL)")
+	simulateCmd.Flags().StringVar(&simScenario, "scenario", "", "Scenario YAML file or built-in story arc name to drive mission phases")
 	simulateCmd.Flags().BoolVar(&simEnableDetections, "detections", true, "Enable enemy detection stream")
 	simulateCmd.Flags().BoolVar(&simEnableSwarmEvents, "swarm-events", true, "Enable swarm event stream")
 	simulateCmd.Flags().BoolVar(&simEnableMovement, "movement-metrics", true, "Enable drone movement telemetry stream")
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"droneops-sim/internal/scenario"
+)
+
+var (
+	validateScenario       string
+	validateScenarioSchema string
+)
+
+var validateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Validate a scenario file",
+	Long:  "validate checks a scenario file against its CUE schema and for semantic errors such as unknown or unreachable phases.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		issues, err := scenario.Validate(validateScenario, validateScenarioSchema)
+		if err != nil {
+			return err
+		}
+		for _, is := range issues {
+			fmt.Fprintln(cmd.OutOrStdout(), is)
+		}
+		if len(issues) > 0 {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+			return fmt.Errorf("%s: %d issue(s) found", validateScenario, len(issues))
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "%s: ok\n", validateScenario)
+		return nil
+	},
+}
+
+func init() {
+	validateCmd.Flags().StringVar(&validateScenario, "scenario", "", "Path to scenario YAML to validate")
+	validateCmd.Flags().StringVar(&validateScenarioSchema, "scenario-schema", "schemas/scenario.cue", "Path to scenario CUE schema file")
+	validateCmd.MarkFlagRequired("scenario")
+}
@@ -9,7 +9,7 @@ import (
 
 // newWriters sets up telemetry, detection, and mission writers based on flags and env vars.
 // It returns the writers and a cleanup function to close any resources.
-func newWriters(cfg *config.SimulationConfig, printOnly bool, logFile string, enableDetections, enableSwarm, enableState bool) (sim.TelemetryWriter, sim.DetectionWriter, sim.MissionWriter, func(), error) {
+func newWriters(cfg *config.SimulationConfig, printOnly bool, logFile string, enableDetections, enableSwarm, enableState, enablePhases bool) (sim.TelemetryWriter, sim.DetectionWriter, sim.MissionWriter, func(), error) {
 	cleanup := func() {}
 
 	writer, detectWriter, missionWriter, err := baseWriters(cfg, printOnly)
@@ -23,9 +23,10 @@ func newWriters(cfg *config.SimulationConfig, printOnly bool, logFile string, en
 		return writer, detectWriter, missionWriter, cleanup, nil
 	}
 
-	detPath := ""
+	detPath, poiPath := "", ""
 	if enableDetections {
 		detPath = logFile + ".detections"
+		poiPath = logFile + ".poi"
 	}
 	swarmPath := ""
 	if enableSwarm {
@@ -35,7 +36,23 @@ func newWriters(cfg *config.SimulationConfig, printOnly bool, logFile string, en
 	if enableState {
 		statePath = logFile + ".state"
 	}
-	fw, err := sim.NewFileWriter(logFile, detPath, swarmPath, statePath)
+	phasePath := ""
+	if enablePhases {
+		phasePath = logFile + ".phases"
+	}
+	convoyPath := ""
+	if cfg != nil && len(cfg.Convoys) > 0 {
+		convoyPath = logFile + ".convoy"
+	}
+	assetPath := ""
+	if cfg != nil && len(cfg.FixedAssets) > 0 {
+		assetPath = logFile + ".assets"
+	}
+	geofencePath := ""
+	if cfg != nil && len(cfg.RestrictedAreas) > 0 {
+		geofencePath = logFile + ".geofence"
+	}
+	fw, err := sim.NewFileWriter(logFile, detPath, swarmPath, statePath, phasePath, poiPath, convoyPath, assetPath, geofencePath)
 	if err != nil {
 		return nil, nil, nil, nil, err
 	}
@@ -83,7 +100,12 @@ func baseWriters(cfg *config.SimulationConfig, printOnly bool) (sim.TelemetryWri
 	swarmTable := os.Getenv("SWARM_EVENT_TABLE")
 	stateTable := os.Getenv("SIMULATION_STATE_TABLE")
 	missionTable := os.Getenv("MISSIONS_TABLE")
-	w, err := sim.NewGreptimeDBWriter(endpoint, database, table, detTable, swarmTable, stateTable, missionTable)
+	phaseTable := os.Getenv("SCENARIO_PHASE_TABLE")
+	poiTable := os.Getenv("POI_DETECTION_TABLE")
+	convoyTable := os.Getenv("CONVOY_TABLE")
+	assetTable := os.Getenv("ASSET_TABLE")
+	geofenceTable := os.Getenv("GEOFENCE_BREACH_TABLE")
+	w, err := sim.NewGreptimeDBWriter(endpoint, database, table, detTable, swarmTable, stateTable, missionTable, phaseTable, poiTable, convoyTable, assetTable, geofenceTable)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -92,6 +114,6 @@ func baseWriters(cfg *config.SimulationConfig, printOnly bool) (sim.TelemetryWri
 
 // newTelemetryWriter creates a telemetry writer without detection handling.
 func newTelemetryWriter(cfg *config.SimulationConfig, printOnly bool) (sim.TelemetryWriter, error) {
-	w, _, _, _, err := newWriters(cfg, printOnly, "", true, true, true)
+	w, _, _, _, err := newWriters(cfg, printOnly, "", true, true, true, false)
 	return w, err
 }
@@ -11,7 +11,7 @@ import (
 )
 
 func TestNewWritersPrintOnly(t *testing.T) {
-	tw, dw, mw, cleanup, err := newWriters(nil, true, "", true, true, true)
+	tw, dw, mw, cleanup, err := newWriters(nil, true, "", true, true, true, true)
 	if err != nil {
 		t.Fatalf("newWriters returned error: %v", err)
 	}
@@ -29,7 +29,7 @@ func TestNewWritersPrintOnly(t *testing.T) {
 
 func TestNewWritersGreptimeFallback(t *testing.T) {
 	t.Setenv("GREPTIMEDB_ENDPOINT", "")
-	tw, dw, mw, cleanup, err := newWriters(nil, false, "", true, true, true)
+	tw, dw, mw, cleanup, err := newWriters(nil, false, "", true, true, true, true)
 	if err != nil {
 		t.Fatalf("newWriters returned error: %v", err)
 	}
@@ -48,7 +48,7 @@ func TestNewWritersGreptimeFallback(t *testing.T) {
 func TestNewWritersLogFile(t *testing.T) {
 	dir := t.TempDir()
 	path := filepath.Join(dir, "telemetry.log")
-	tw, _, mw, cleanup, err := newWriters(nil, true, path, true, true, true)
+	tw, _, mw, cleanup, err := newWriters(nil, true, path, true, true, true, true)
 	if err != nil {
 		t.Fatalf("newWriters returned error: %v", err)
 	}
@@ -88,7 +88,7 @@ func TestNewWritersLogFile(t *testing.T) {
 }
 
 func TestNewWritersDisableDetections(t *testing.T) {
-	tw, dw, mw, cleanup, err := newWriters(nil, true, "", false, true, true)
+	tw, dw, mw, cleanup, err := newWriters(nil, true, "", false, true, true, true)
 	if err != nil {
 		t.Fatalf("newWriters returned error: %v", err)
 	}
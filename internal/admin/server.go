@@ -9,6 +9,7 @@ import (
 	"strconv"
 
 	"droneops-sim/internal/config"
+	"droneops-sim/internal/coverage"
 	"droneops-sim/internal/sim"
 )
 
@@ -33,10 +34,12 @@ func (s *Server) routes() {
 	http.HandleFunc("/", s.handleIndex)
 	http.HandleFunc("/3d", s.handle3D)
 	http.HandleFunc("/map-data", s.handleMapData)
+	http.HandleFunc("/coverage", s.handleCoverage)
 	http.HandleFunc("/telemetry", s.handleTelemetry)
 	http.HandleFunc("/toggle-chaos", s.handleToggleChaos)
 	http.HandleFunc("/launch-drones", s.handleLaunch)
 	http.HandleFunc("/fleet-health", s.handleHealth)
+	http.HandleFunc("/scenario", s.handleScenario)
 	http.HandleFunc("/observer", s.handleObserver)
 	http.HandleFunc("/observer/events", s.handleObserverEvents)
 	http.HandleFunc("/observer/step", s.handleObserverStep)
@@ -57,10 +60,12 @@ func (s *Server) Start(ctx context.Context, addr string) error {
 func (s *Server) handleIndex(w http.ResponseWriter, r *http.Request) {
 	data := struct {
 		Chaos        bool
+		Scenario     sim.ScenarioStatus
 		Fleets       []sim.FleetHealth
 		FleetDetails []config.Fleet // Add detailed fleet information
 	}{
 		Chaos:        s.Sim.Chaos(),
+		Scenario:     s.Sim.ScenarioStatus(),
 		Fleets:       s.Sim.Health(),
 		FleetDetails: s.Sim.GetConfig().Fleets, // Use GetConfig to access fleet details
 	}
@@ -92,6 +97,11 @@ func (s *Server) handleHealth(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(s.Sim.Health())
 }
 
+func (s *Server) handleScenario(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(s.Sim.ScenarioStatus())
+}
+
 func (s *Server) handle3D(w http.ResponseWriter, r *http.Request) {
 	s.mapTpl.Execute(w, nil)
 }
@@ -106,6 +116,25 @@ func (s *Server) handleMapData(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(s.Sim.MapSnapshot())
 }
 
+func (s *Server) handleCoverage(w http.ResponseWriter, r *http.Request) {
+	heatmaps := s.Sim.CoverageHeatmaps()
+	if mission := r.URL.Query().Get("mission"); mission != "" {
+		var filtered []coverage.Heatmap
+		for _, h := range heatmaps {
+			if h.MissionID == mission {
+				filtered = append(filtered, h)
+			}
+		}
+		if filtered == nil {
+			http.Error(w, "unknown mission", http.StatusNotFound)
+			return
+		}
+		heatmaps = filtered
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(heatmaps)
+}
+
 func (s *Server) handleObserver(w http.ResponseWriter, r *http.Request) {
 	s.observerTpl.Execute(w, nil)
 }
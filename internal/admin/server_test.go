@@ -9,6 +9,8 @@ import (
 	"time"
 
 	"droneops-sim/internal/config"
+	"droneops-sim/internal/coverage"
+	"droneops-sim/internal/scenario"
 	"droneops-sim/internal/sim"
 	"droneops-sim/internal/telemetry"
 )
@@ -172,6 +174,55 @@ func TestHandleMapData(t *testing.T) {
 	}
 }
 
+func TestHandleCoverage(t *testing.T) {
+	cfg := &config.SimulationConfig{
+		Zones:    []config.Region{{Name: "r1", CenterLat: 0, CenterLon: 0, RadiusKM: 1}},
+		Fleets:   []config.Fleet{{Name: "f1", Model: "small-fpv", Count: 2, MovementPattern: "search", HomeRegion: "r1", MissionID: "m1"}},
+		Missions: []config.Mission{{ID: "m1", Name: "m1", Region: config.Region{Name: "r1", CenterLat: 0, CenterLon: 0, RadiusKM: 1}}},
+	}
+	simulator := sim.NewSimulator("cluster", cfg, nil, nil, 1, rand.New(rand.NewSource(1)), func() time.Time { return time.Unix(0, 0).UTC() })
+	server := NewServer(simulator)
+
+	w := httptest.NewRecorder()
+	server.handleCoverage(w, httptest.NewRequest(http.MethodGet, "/coverage?mission=m1", nil))
+	var heatmaps []coverage.Heatmap
+	if err := json.NewDecoder(w.Result().Body).Decode(&heatmaps); err != nil {
+		t.Fatalf("decode error: %v", err)
+	}
+	if len(heatmaps) != 1 || heatmaps[0].MissionID != "m1" || len(heatmaps[0].Cells) == 0 {
+		t.Fatalf("expected heatmap of mission m1, got %+v", heatmaps)
+	}
+
+	w = httptest.NewRecorder()
+	server.handleCoverage(w, httptest.NewRequest(http.MethodGet, "/coverage?mission=other", nil))
+	if w.Result().StatusCode != http.StatusNotFound {
+		t.Fatalf("expected not found for unknown mission, got %v", w.Result().StatusCode)
+	}
+}
+
+func TestHandleScenario(t *testing.T) {
+	cfg := &config.SimulationConfig{
+		Zones:  []config.Region{{Name: "r1", CenterLat: 0, CenterLon: 0, RadiusKM: 1}},
+		Fleets: []config.Fleet{{Name: "f1", Model: "small-fpv", Count: 1}},
+	}
+	simulator := sim.NewSimulator("cluster", cfg, nil, nil, 1, rand.New(rand.NewSource(1)), func() time.Time { return time.Unix(0, 0).UTC() })
+	arc := scenario.BuiltIn()["escort"]
+	simulator.SetScenario(&arc)
+	server := NewServer(simulator)
+
+	req := httptest.NewRequest(http.MethodGet, "/scenario", nil)
+	w := httptest.NewRecorder()
+	server.handleScenario(w, req)
+
+	var st sim.ScenarioStatus
+	if err := json.NewDecoder(w.Body).Decode(&st); err != nil {
+		t.Fatalf("decode error: %v", err)
+	}
+	if !st.Active || st.Name != "Escort" || st.Phase != "setup" {
+		t.Errorf("unexpected scenario status: %+v", st)
+	}
+}
+
 func TestObserverEndpoints(t *testing.T) {
 	cfg := &config.SimulationConfig{
 		Zones:  []config.Region{{Name: "r1", CenterLat: 0, CenterLon: 0, RadiusKM: 1}},
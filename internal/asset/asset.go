@@ -0,0 +1,25 @@
+package asset
+
+// Damage removes hit points, marking the asset damaged or, at zero,
+// destroyed. It reports whether this damage destroyed the asset.
+func (a *Asset) Damage(amount float64) bool {
+	if a.Status == StatusDestroyed || amount <= 0 {
+		return false
+	}
+	a.HitPoints -= amount
+	if a.HitPoints > 0 {
+		a.Status = StatusDamaged
+		return false
+	}
+	a.HitPoints = 0
+	a.Status = StatusDestroyed
+	return true
+}
+
+// Health returns the remaining hit points in percent of the maximum.
+func (a *Asset) Health() float64 {
+	if a.MaxHitPoints <= 0 {
+		return 0
+	}
+	return 100 * a.HitPoints / a.MaxHitPoints
+}
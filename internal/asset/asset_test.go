@@ -0,0 +1,22 @@
+package asset
+
+import "testing"
+
+func TestDamageAndHealth(t *testing.T) {
+	a := &Asset{ID: "station", HitPoints: 200, MaxHitPoints: 200, Status: StatusOperational}
+	if a.Health() != 100 {
+		t.Fatalf("expected full health, got %f", a.Health())
+	}
+	if a.Damage(0) || a.Status != StatusOperational {
+		t.Fatalf("expected zero damage to leave the asset operational, got %+v", a)
+	}
+	if a.Damage(50) || a.Status != StatusDamaged || a.Health() != 75 {
+		t.Fatalf("expected damaged asset at 75%%, got %+v", a)
+	}
+	if !a.Damage(500) || a.Status != StatusDestroyed || a.HitPoints != 0 {
+		t.Fatalf("expected destroyed asset, got %+v", a)
+	}
+	if a.Damage(1) {
+		t.Fatalf("expected destroyed asset to ignore further damage")
+	}
+}
@@ -0,0 +1,50 @@
+// Package asset models fixed installations such as relay stations or bases
+// that enemies attack and drones defend.
+package asset
+
+import (
+	"time"
+
+	"droneops-sim/internal/telemetry"
+)
+
+// Status tracks the condition of a fixed asset.
+type Status string
+
+const (
+	// StatusOperational indicates the asset has not taken damage.
+	StatusOperational Status = "operational"
+	// StatusDamaged indicates the asset has lost hit points.
+	StatusDamaged Status = "damaged"
+	// StatusDestroyed indicates the asset has no hit points left.
+	StatusDestroyed Status = "destroyed"
+)
+
+// Asset is a static installation at Position. Attackers within RadiusM
+// reduce HitPoints, which start at MaxHitPoints.
+type Asset struct {
+	ID           string
+	Owner        string
+	Position     telemetry.Position
+	RadiusM      float64
+	HitPoints    float64
+	MaxHitPoints float64
+	Status       Status
+}
+
+// StateRow records an asset's condition for one tick. Attackers counts the
+// enemies that damaged the asset during the tick.
+type StateRow struct {
+	ClusterID string    `json:"cluster_id"`
+	AssetID   string    `json:"asset_id"`
+	Owner     string    `json:"owner"`
+	Status    Status    `json:"status"`
+	Lat       float64   `json:"lat"`
+	Lon       float64   `json:"lon"`
+	Alt       float64   `json:"alt"`
+	RadiusM   float64   `json:"radius_m"`
+	HitPoints float64   `json:"hit_points"`
+	Health    float64   `json:"health"`
+	Attackers int       `json:"attackers"`
+	Timestamp time.Time `json:"ts"`
+}
@@ -5,6 +5,11 @@ import (
 	"fmt"
 	log "log/slog"
 	"os"
+	"path/filepath"
+
+	"droneops-sim/internal/geo"
+	"droneops-sim/internal/telemetry"
+	"droneops-sim/internal/terrain"
 
 	"cuelang.org/go/cue"
 	"cuelang.org/go/cue/cuecontext"
@@ -23,23 +28,40 @@ type Behavior struct {
 	BatteryAnomalyRate float64 `yaml:"battery_anomaly_rate"`
 }
 
-// Region defines an operational region
+// Region defines an operational region, a circle around the center or the
+// polygons of an inline GeoJSON geometry or a GeoJSON file. Load resolves
+// the polygons into Shape and fills in their bounding circle where the
+// center and radius are not set.
 type Region struct {
-	Name      string  `yaml:"name"`
-	CenterLat float64 `yaml:"center_lat"`
-	CenterLon float64 `yaml:"center_lon"`
-	RadiusKM  float64 `yaml:"radius_km"`
+	Name      string           `yaml:"name"`
+	CenterLat float64          `yaml:"center_lat"`
+	CenterLon float64          `yaml:"center_lon"`
+	RadiusKM  float64          `yaml:"radius_km"`
+	Geometry  map[string]any   `yaml:"geometry"`
+	GeoJSON   string           `yaml:"geojson"`
+	Shape     geo.MultiPolygon `yaml:"-"`
 }
 
 // Fleet defines a fleet of drones of the same model and behavior
 type Fleet struct {
-	Name            string   `yaml:"name"`
-	Model           string   `yaml:"model"`
-	Count           int      `yaml:"count"`
-	MovementPattern string   `yaml:"movement_pattern"`
-	HomeRegion      string   `yaml:"home_region"`
-	MissionID       string   `yaml:"mission_id"`
-	Behavior        Behavior `yaml:"behavior"`
+	Name              string         `yaml:"name"`
+	Model             string         `yaml:"model"`
+	Count             int            `yaml:"count"`
+	MovementPattern   string         `yaml:"movement_pattern"`
+	PatternParams     map[string]any `yaml:"pattern_params"`
+	HomeRegion        string         `yaml:"home_region"`
+	MissionID         string         `yaml:"mission_id"`
+	Escort            string         `yaml:"escort"`
+	Route             string         `yaml:"route"`
+	Base              *Waypoint      `yaml:"base"`
+	BaseStation       string         `yaml:"base_station"`
+	CruiseAltM        float64        `yaml:"cruise_alt_m"`
+	AltitudeMode      string         `yaml:"altitude_mode"`
+	Formation         string         `yaml:"formation"`
+	FormationSpacingM float64        `yaml:"formation_spacing_m"`
+	SearchPattern     string         `yaml:"search_pattern"`
+	SwathWidthM       float64        `yaml:"swath_width_m"`
+	Behavior          Behavior       `yaml:"behavior"`
 }
 
 // Mission describes a named mission that operates within a zone
@@ -49,6 +71,7 @@ type Mission struct {
 	Objective   string `yaml:"objective"`
 	Description string `yaml:"description"`
 	Region      Region `yaml:"region"`
+	OnStation   int    `yaml:"on_station"`
 }
 
 // TelemetryToggles controls emission of telemetry streams.
@@ -59,22 +82,152 @@ type TelemetryToggles struct {
 	SimulationState *bool `yaml:"simulation_state"`
 }
 
+// PointOfInterest places a survivor, wreckage or supply cache for drones to find.
+type PointOfInterest struct {
+	ID         string  `yaml:"id"`
+	Type       string  `yaml:"type"`
+	Lat        float64 `yaml:"lat"`
+	Lon        float64 `yaml:"lon"`
+	Alt        float64 `yaml:"alt"`
+	Difficulty float64 `yaml:"difficulty"`
+}
+
+// Waypoint is a point on a route.
+type Waypoint struct {
+	Lat float64 `yaml:"lat"`
+	Lon float64 `yaml:"lon"`
+	Alt float64 `yaml:"alt"`
+}
+
+// Route is a named waypoint path flown by point-to-point fleets. Mode is
+// loop, ping-pong or one-way; a waypoint counts as reached within
+// ArrivalRadiusM.
+type Route struct {
+	Name           string     `yaml:"name"`
+	Mode           string     `yaml:"mode"`
+	ArrivalRadiusM float64    `yaml:"arrival_radius_m"`
+	Waypoints      []Waypoint `yaml:"waypoints"`
+}
+
+// Convoy defines a friendly ground convoy that starts at the first route
+// waypoint and travels to the last one.
+type Convoy struct {
+	ID       string     `yaml:"id"`
+	SpeedMPS float64    `yaml:"speed_mps"`
+	Health   float64    `yaml:"health"`
+	Route    []Waypoint `yaml:"route"`
+}
+
+// FixedAsset declares a static installation, such as a relay station, that
+// enemies with attack objectives damage when they come within RadiusM.
+type FixedAsset struct {
+	ID        string  `yaml:"id"`
+	Owner     string  `yaml:"owner"`
+	Lat       float64 `yaml:"lat"`
+	Lon       float64 `yaml:"lon"`
+	Alt       float64 `yaml:"alt"`
+	RadiusM   float64 `yaml:"radius_m"`
+	HitPoints float64 `yaml:"hit_points"`
+}
+
+// BaseStation is a charging base that fleets launch from and return to. Idle
+// drones recharge at ChargeRate percent per second, at most ChargeSlots at once.
+type BaseStation struct {
+	ID          string  `yaml:"id"`
+	Lat         float64 `yaml:"lat"`
+	Lon         float64 `yaml:"lon"`
+	Alt         float64 `yaml:"alt"`
+	ChargeSlots int     `yaml:"charge_slots"`
+	ChargeRate  float64 `yaml:"charge_rate"`
+}
+
+// WeatherCell is a storm or fog bank that drifts across the zones and
+// degrades sensors and communications within RadiusKM.
+type WeatherCell struct {
+	ID              string  `yaml:"id"`
+	Type            string  `yaml:"type"`
+	Lat             float64 `yaml:"lat"`
+	Lon             float64 `yaml:"lon"`
+	RadiusKM        float64 `yaml:"radius_km"`
+	Intensity       float64 `yaml:"intensity"`
+	DriftSpeedMPS   float64 `yaml:"drift_speed_mps"`
+	DriftHeadingDeg float64 `yaml:"drift_heading_deg"`
+}
+
+// Wind configures the prevailing wind. The direction swings by up to VeerDeg
+// over PeriodS seconds, random gusts add up to GustMPS, and regional entries
+// replace direction and speed inside a zone.
+type Wind struct {
+	DirectionDeg float64        `yaml:"direction_deg"`
+	SpeedMPS     float64        `yaml:"speed_mps"`
+	GustMPS      float64        `yaml:"gust_mps"`
+	VeerDeg      float64        `yaml:"veer_deg"`
+	PeriodS      float64        `yaml:"period_s"`
+	Regions      []RegionalWind `yaml:"regions"`
+}
+
+// RegionalWind overrides the prevailing wind inside the named zone.
+type RegionalWind struct {
+	Region       string  `yaml:"region"`
+	DirectionDeg float64 `yaml:"direction_deg"`
+	SpeedMPS     float64 `yaml:"speed_mps"`
+}
+
+// Collisions configures inter-drone safety. Drones closer than RadiusM
+// collide, and FailDrones fails both drones of a collision.
+type Collisions struct {
+	RadiusM    float64 `yaml:"radius_m"`
+	FailDrones bool    `yaml:"fail_drones"`
+}
+
+// RestrictedArea is a no-fly zone between FloorM and CeilingM meters, from
+// the ground and without ceiling when zero, given as an inline GeoJSON
+// geometry or a GeoJSON file. It is active from ActiveFromS to ActiveUntilS
+// seconds after the simulation starts, to the end when ActiveUntilS is zero.
+type RestrictedArea struct {
+	ID           string           `yaml:"id"`
+	Geometry     map[string]any   `yaml:"geometry"`
+	GeoJSON      string           `yaml:"geojson"`
+	FloorM       float64          `yaml:"floor_m"`
+	CeilingM     float64          `yaml:"ceiling_m"`
+	ActiveFromS  float64          `yaml:"active_from_s"`
+	ActiveUntilS float64          `yaml:"active_until_s"`
+	Shape        geo.MultiPolygon `yaml:"-"`
+}
+
+// Terrain points to a digital elevation model, an ESRI ASCII grid or a
+// GeoTIFF in geographic coordinates. Load reads it into Grid.
+type Terrain struct {
+	DEM  string        `yaml:"dem"`
+	Grid *terrain.Grid `yaml:"-"`
+}
+
 // SimulationConfig is the root configuration for zones, missions, and fleets
 type SimulationConfig struct {
-	Zones              []Region         `yaml:"zones"`
-	Missions           []Mission        `yaml:"missions"`
-	Fleets             []Fleet          `yaml:"fleets"`
-	EnemyCount         int              `yaml:"enemy_count"`
-	DetectionRadiusM   float64          `yaml:"detection_radius_m"`
-	SensorNoise        float64          `yaml:"sensor_noise"`
-	TerrainOcclusion   float64          `yaml:"terrain_occlusion"`
-	WeatherImpact      float64          `yaml:"weather_impact"`
-	FollowConfidence   float64          `yaml:"follow_confidence"`
-	SwarmResponses     map[string]int   `yaml:"swarm_responses"`
-	MissionCriticality string           `yaml:"mission_criticality"`
-	CommunicationLoss  float64          `yaml:"communication_loss"`
-	BandwidthLimit     int              `yaml:"bandwidth_limit"`
-	Telemetry          TelemetryToggles `yaml:"telemetry"`
+	Zones              []Region          `yaml:"zones"`
+	Missions           []Mission         `yaml:"missions"`
+	Fleets             []Fleet           `yaml:"fleets"`
+	Routes             []Route           `yaml:"routes"`
+	EnemyCount         int               `yaml:"enemy_count"`
+	DetectionRadiusM   float64           `yaml:"detection_radius_m"`
+	SensorNoise        float64           `yaml:"sensor_noise"`
+	TerrainOcclusion   float64           `yaml:"terrain_occlusion"`
+	WeatherImpact      float64           `yaml:"weather_impact"`
+	FollowConfidence   float64           `yaml:"follow_confidence"`
+	SwarmResponses     map[string]int    `yaml:"swarm_responses"`
+	MissionCriticality string            `yaml:"mission_criticality"`
+	CommunicationLoss  float64           `yaml:"communication_loss"`
+	BandwidthLimit     int               `yaml:"bandwidth_limit"`
+	Telemetry          TelemetryToggles  `yaml:"telemetry"`
+	PointsOfInterest   []PointOfInterest `yaml:"points_of_interest"`
+	Convoys            []Convoy          `yaml:"convoys"`
+	FixedAssets        []FixedAsset      `yaml:"fixed_assets"`
+	BaseStations       []BaseStation     `yaml:"base_stations"`
+	Wind               Wind              `yaml:"wind"`
+	WeatherCells       []WeatherCell     `yaml:"weather_cells"`
+	Collisions         Collisions        `yaml:"collisions"`
+	RestrictedAreas    []RestrictedArea  `yaml:"restricted_areas"`
+	Terrain            Terrain           `yaml:"terrain"`
 }
 
 // Load loads YAML config and validates it against a CUE schema
@@ -93,6 +246,32 @@ func Load(configPath, cueSchemaPath string) (*SimulationConfig, error) {
 		return nil, err
 	}
 
+	dir := filepath.Dir(configPath)
+	for i := range cfg.Zones {
+		if err := cfg.Zones[i].resolve(dir); err != nil {
+			return nil, fmt.Errorf("zone %q: %w", cfg.Zones[i].Name, err)
+		}
+	}
+	for i := range cfg.Missions {
+		if err := cfg.Missions[i].Region.resolve(dir); err != nil {
+			return nil, fmt.Errorf("mission %q: %w", cfg.Missions[i].ID, err)
+		}
+	}
+	for i := range cfg.RestrictedAreas {
+		if err := cfg.RestrictedAreas[i].resolve(dir); err != nil {
+			return nil, fmt.Errorf("restricted area %q: %w", cfg.RestrictedAreas[i].ID, err)
+		}
+	}
+	if cfg.Terrain.DEM != "" {
+		path := cfg.Terrain.DEM
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(dir, path)
+		}
+		if cfg.Terrain.Grid, err = terrain.Load(path); err != nil {
+			return nil, fmt.Errorf("terrain: %w", err)
+		}
+	}
+
 	setDefault := func(b **bool) {
 		if *b == nil {
 			v := true
@@ -127,12 +306,13 @@ func ValidateWithCue(configFile, cueFile string) error {
 		return fmt.Errorf("invalid YAML config: %w", err)
 	}
 
-	// Read CUE schema
+	// Read CUE schema, completed by the registered movement patterns
 	schemaBytes, err := os.ReadFile(cueFile)
 	if err != nil {
 		return fmt.Errorf("cannot read CUE schema: %w", err)
 	}
-	schemaVal := ctx.CompileBytes(schemaBytes)
+	schemaBytes = append(schemaBytes, "\n"+telemetry.PatternSchema()...)
+	schemaVal := ctx.CompileBytes(schemaBytes, cue.Filename(cueFile))
 	if err := schemaVal.Validate(cue.All()); err != nil {
 		return fmt.Errorf("invalid CUE schema: %w", err)
 	}
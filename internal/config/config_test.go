@@ -1,8 +1,13 @@
 package config
 
 import (
+	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+
+	"droneops-sim/internal/telemetry"
 )
 
 func TestLoadConfig_Valid(t *testing.T) {
@@ -79,3 +84,262 @@ fleets: []
 		t.Fatalf("expected validation error for invalid config")
 	}
 }
+
+func TestLoadSampleRoutes(t *testing.T) {
+	cfg, err := Load("../../config/simulation.yaml", "../../schemas/simulation.cue")
+	if err != nil {
+		t.Fatalf("Load() returned error: %v", err)
+	}
+	if len(cfg.Routes) != 1 || cfg.Routes[0].Mode != "ping-pong" || len(cfg.Routes[0].Waypoints) != 3 || cfg.Routes[0].Waypoints[1].Alt != 150 {
+		t.Fatalf("unexpected routes: %+v", cfg.Routes)
+	}
+	if cfg.Fleets[1].Route != "supply-run" {
+		t.Fatalf("expected transport fleet on supply-run, got %+v", cfg.Fleets[1])
+	}
+	if len(cfg.BaseStations) != 1 || cfg.BaseStations[0].Lat != 48.11 || cfg.Fleets[2].BaseStation != cfg.BaseStations[0].ID || cfg.Fleets[2].CruiseAltM != 150 {
+		t.Fatalf("expected heavy support at the base station, got %+v", cfg.Fleets[2])
+	}
+}
+
+func TestValidateWithCue_InvalidRouteMode(t *testing.T) {
+	tmpFile := "invalid-route.yaml"
+	defer os.Remove(tmpFile)
+	yaml := `
+zones: []
+missions: []
+fleets: []
+routes:
+  - name: r
+    mode: zigzag
+    waypoints:
+      - {lat: 1, lon: 2}
+`
+	if err := os.WriteFile(tmpFile, []byte(yaml), 0644); err != nil {
+		t.Fatalf("failed to write temp file: %v", err)
+	}
+	if err := ValidateWithCue(tmpFile, "../../schemas/simulation.cue"); err == nil {
+		t.Fatalf("expected validation error for unknown route mode")
+	}
+}
+
+func TestLoadEscortSample(t *testing.T) {
+	cfg, err := Load("../../config/simulation_escort.yaml", "../../schemas/simulation.cue")
+	if err != nil {
+		t.Fatalf("Load() returned error: %v", err)
+	}
+	if len(cfg.Convoys) != 1 || len(cfg.Convoys[0].Route) != 4 || cfg.Convoys[0].SpeedMPS != 12 {
+		t.Fatalf("unexpected convoys: %+v", cfg.Convoys)
+	}
+	if cfg.Fleets[0].MovementPattern != "escort" || cfg.Fleets[0].Escort != "convoy" {
+		t.Fatalf("unexpected escort fleet: %+v", cfg.Fleets[0])
+	}
+}
+
+func TestLoadDefensiveStandSample(t *testing.T) {
+	cfg, err := Load("../../config/simulation_defensive_stand.yaml", "../../schemas/simulation.cue")
+	if err != nil {
+		t.Fatalf("Load() returned error: %v", err)
+	}
+	if len(cfg.FixedAssets) != 2 || cfg.FixedAssets[0].ID != "station" || cfg.FixedAssets[0].HitPoints != 200 || cfg.FixedAssets[0].RadiusM != 250 {
+		t.Fatalf("unexpected fixed assets: %+v", cfg.FixedAssets)
+	}
+}
+
+func TestValidateWithCue_PatternParams(t *testing.T) {
+	telemetry.RegisterPattern(telemetry.Pattern{
+		Name:   "config-test-zigzag",
+		Params: []telemetry.Param{{Name: "leg_m", Type: telemetry.ParamNumber, Constraint: ">0"}},
+		New:    func(telemetry.Params) telemetry.MovementStrategy { return telemetry.LoiterMovement{} },
+	})
+	tmpFile := "pattern-params.yaml"
+	defer os.Remove(tmpFile)
+	fleet := `
+zones: []
+missions: []
+fleets:
+  - name: f
+    model: small-fpv
+    count: 1
+    movement_pattern: %s
+    home_region: z
+    mission_id: m
+    pattern_params: {%s}
+`
+	cases := []struct {
+		pattern, params string
+		valid           bool
+	}{
+		{"patrol", "radius_scale: 0.8", true},
+		{"patrol", "radius_scale: 2", false},
+		{"patrol", "leg_m: 10", false},
+		{"config-test-zigzag", "leg_m: 10", true},
+		{"config-test-zigzag", "leg_m: fast", false},
+		{"unknown", "", false},
+	}
+	for _, c := range cases {
+		if err := os.WriteFile(tmpFile, []byte(fmt.Sprintf(fleet, c.pattern, c.params)), 0644); err != nil {
+			t.Fatalf("failed to write temp file: %v", err)
+		}
+		if err := ValidateWithCue(tmpFile, "../../schemas/simulation.cue"); (err == nil) != c.valid {
+			t.Errorf("%s {%s}: expected valid=%t, got %v", c.pattern, c.params, c.valid, err)
+		}
+	}
+}
+
+func TestLoadPolygonRegions(t *testing.T) {
+	dir := t.TempDir()
+	border := `{"type": "FeatureCollection", "features": [
+  {"type": "Feature", "properties": {"name": "north"}, "geometry": {"type": "Polygon", "coordinates": [[[16.0, 48.0], [17.0, 48.0], [17.0, 49.0], [16.0, 49.0], [16.0, 48.0]]]}},
+  {"type": "Feature", "properties": {"name": "road"}, "geometry": {"type": "LineString", "coordinates": [[16.0, 48.0], [17.0, 49.0]]}}
+]}`
+	if err := os.WriteFile(filepath.Join(dir, "border.geojson"), []byte(border), 0644); err != nil {
+		t.Fatalf("failed to write GeoJSON: %v", err)
+	}
+	yaml := `
+zones:
+  - name: border
+    geojson: border.geojson
+missions:
+  - id: m
+    name: m
+    objective: o
+    description: d
+    region:
+      name: valley
+      geometry:
+        type: MultiPolygon
+        coordinates:
+          - [[[16.2, 48.2], [16.4, 48.2], [16.4, 48.4], [16.2, 48.4], [16.2, 48.2]]]
+          - [[[16.6, 48.6], [16.8, 48.6], [16.7, 48.8], [16.6, 48.6]]]
+fleets: []
+`
+	path := filepath.Join(dir, "simulation.yaml")
+	if err := os.WriteFile(path, []byte(yaml), 0644); err != nil {
+		t.Fatalf("failed to write temp file: %v", err)
+	}
+	cfg, err := Load(path, "../../schemas/simulation.cue")
+	if err != nil {
+		t.Fatalf("Load() returned error: %v", err)
+	}
+	z := cfg.Zones[0]
+	if len(z.Shape) != 1 || z.CenterLat != 48.5 || z.CenterLon != 16.5 || z.RadiusKM <= 0 {
+		t.Fatalf("expected the file's polygon with its bounding circle, got %+v", z)
+	}
+	if !z.Telemetry().Contains(48.9, 16.9) || z.Telemetry().Contains(49.1, 16.5) {
+		t.Fatalf("expected membership by the polygon")
+	}
+	r := cfg.Missions[0].Region.Telemetry()
+	if len(r.Shape) != 2 || !r.Contains(48.3, 16.3) || !r.Contains(48.65, 16.7) || r.Contains(48.5, 16.5) {
+		t.Fatalf("expected the inline multipolygon, got %+v", r)
+	}
+	if !r.Contains(r.CenterLat, r.CenterLon) || r.RadiusKM <= 0 {
+		t.Fatalf("expected the center moved inside the multipolygon, got %+v", r)
+	}
+
+	bad := strings.Replace(yaml, "geojson: border.geojson", "geojson: missing.geojson", 1)
+	if err := os.WriteFile(path, []byte(bad), 0644); err != nil {
+		t.Fatalf("failed to write temp file: %v", err)
+	}
+	if _, err := Load(path, "../../schemas/simulation.cue"); err == nil || !strings.Contains(err.Error(), `zone "border"`) {
+		t.Fatalf("expected an error naming the zone, got %v", err)
+	}
+	bad = strings.Replace(yaml, "geojson: border.geojson", "center_lat: 48", 1)
+	if err := os.WriteFile(path, []byte(bad), 0644); err != nil {
+		t.Fatalf("failed to write temp file: %v", err)
+	}
+	if _, err := Load(path, "../../schemas/simulation.cue"); err == nil {
+		t.Fatalf("expected an error for a zone without radius or polygon")
+	}
+}
+
+func TestLoadRestrictedAreas(t *testing.T) {
+	dir := t.TempDir()
+	yaml := `
+zones:
+  - name: z
+    center_lat: 48
+    center_lon: 16
+    radius_km: 10
+restricted_areas:
+  - id: airport
+    floor_m: 50
+    ceiling_m: 300
+    active_from_s: 60
+    active_until_s: 600
+    geometry:
+      type: Polygon
+      coordinates: [[[16.0, 48.0], [16.1, 48.0], [16.1, 48.1], [16.0, 48.1], [16.0, 48.0]]]
+fleets: []
+`
+	path := filepath.Join(dir, "simulation.yaml")
+	if err := os.WriteFile(path, []byte(yaml), 0644); err != nil {
+		t.Fatalf("failed to write temp file: %v", err)
+	}
+	cfg, err := Load(path, "../../schemas/simulation.cue")
+	if err != nil {
+		t.Fatalf("Load() returned error: %v", err)
+	}
+	a := cfg.RestrictedAreas[0]
+	if a.Active(30) || !a.Active(60) || a.Active(600) {
+		t.Fatalf("expected the area active from 60 s until 600 s")
+	}
+	as := a.Airspace()
+	if !as.Contains(telemetry.Position{Lat: 48.05, Lon: 16.05, Alt: 100}) || as.Contains(telemetry.Position{Lat: 48.05, Lon: 16.05, Alt: 400}) {
+		t.Fatalf("expected the polygon between floor and ceiling, got %+v", as)
+	}
+
+	bad := yaml[:strings.Index(yaml, "    geometry:")] + "fleets: []\n"
+	if err := os.WriteFile(path, []byte(bad), 0644); err != nil {
+		t.Fatalf("failed to write temp file: %v", err)
+	}
+	if _, err := Load(path, "../../schemas/simulation.cue"); err == nil || !strings.Contains(err.Error(), `restricted area "airport"`) {
+		t.Fatalf("expected an error naming the area without geometry, got %v", err)
+	}
+}
+
+func TestLoadTerrain(t *testing.T) {
+	dir := t.TempDir()
+	dem := "ncols 2\nnrows 2\nxllcorner 16\nyllcorner 48\ncellsize 0.1\n100 200\n300 400\n"
+	if err := os.WriteFile(filepath.Join(dir, "dem.asc"), []byte(dem), 0644); err != nil {
+		t.Fatalf("failed to write temp file: %v", err)
+	}
+	yaml := `
+zones:
+  - name: z
+    center_lat: 48
+    center_lon: 16
+    radius_km: 10
+terrain:
+  dem: dem.asc
+fleets:
+  - name: f
+    model: small-fpv
+    count: 1
+    movement_pattern: patrol
+    home_region: z
+    mission_id: m
+    altitude_mode: terrain_following
+`
+	path := filepath.Join(dir, "simulation.yaml")
+	if err := os.WriteFile(path, []byte(yaml), 0644); err != nil {
+		t.Fatalf("failed to write temp file: %v", err)
+	}
+	cfg, err := Load(path, "../../schemas/simulation.cue")
+	if err != nil {
+		t.Fatalf("Load() returned error: %v", err)
+	}
+	if cfg.Terrain.Grid == nil || cfg.Fleets[0].AltitudeMode != telemetry.AltitudeTerrainFollowing {
+		t.Fatalf("expected the DEM loaded and terrain following, got %+v", cfg)
+	}
+	if h := cfg.Terrain.Grid.Elevation(48.15, 16.05); h != 100 {
+		t.Fatalf("expected 100 m in the northwest cell, got %v", h)
+	}
+
+	missing := strings.Replace(yaml, "dem.asc", "missing.asc", 1)
+	if err := os.WriteFile(path, []byte(missing), 0644); err != nil {
+		t.Fatalf("failed to write temp file: %v", err)
+	}
+	if _, err := Load(path, "../../schemas/simulation.cue"); err == nil || !strings.Contains(err.Error(), "terrain:") {
+		t.Fatalf("expected a terrain error for a missing DEM, got %v", err)
+	}
+}
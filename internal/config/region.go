@@ -0,0 +1,100 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"droneops-sim/internal/geo"
+	"droneops-sim/internal/telemetry"
+)
+
+// Telemetry returns the region as flown by drones and roamed by enemies.
+func (r Region) Telemetry() telemetry.Region {
+	return telemetry.Region{Name: r.Name, CenterLat: r.CenterLat, CenterLon: r.CenterLon, RadiusKM: r.RadiusKM, Shape: r.Shape}
+}
+
+// resolve loads the region's polygons, reading GeoJSON files relative to
+// dir, and completes the center and radius from their bounding circle. The
+// center of concave shapes whose bounding box center lies outside them is
+// moved to a point inside.
+func (r *Region) resolve(dir string) error {
+	shape, err := loadShape(r.Geometry, r.GeoJSON, dir)
+	if err != nil {
+		return err
+	}
+	if shape == nil {
+		if r.RadiusKM <= 0 {
+			return fmt.Errorf("region %q needs radius_km, geometry or geojson", r.Name)
+		}
+		return nil
+	}
+	r.Shape = shape
+	if r.RadiusKM <= 0 {
+		lat, lon, radiusM := shape.BoundingCircle()
+		if !shape.Contains(lat, lon) {
+			lat, lon = shape.InteriorPoint()
+			radiusM = shape.Reach(lat, lon)
+		}
+		r.CenterLat, r.CenterLon, r.RadiusKM = lat, lon, radiusM/1000
+	}
+	return nil
+}
+
+// Airspace returns the restricted area as avoided by drones.
+func (a RestrictedArea) Airspace() telemetry.Airspace {
+	return telemetry.Airspace{ID: a.ID, Shape: a.Shape, FloorM: a.FloorM, CeilingM: a.CeilingM}
+}
+
+// Active reports whether the restricted area is in force elapsed seconds
+// after the simulation started.
+func (a RestrictedArea) Active(elapsed float64) bool {
+	return elapsed >= a.ActiveFromS && (a.ActiveUntilS <= 0 || elapsed < a.ActiveUntilS)
+}
+
+// resolve loads the restricted area's polygons, reading GeoJSON files
+// relative to dir.
+func (a *RestrictedArea) resolve(dir string) error {
+	shape, err := loadShape(a.Geometry, a.GeoJSON, dir)
+	if err != nil {
+		return err
+	}
+	if shape == nil {
+		return fmt.Errorf("needs geometry or geojson")
+	}
+	a.Shape = shape
+	return nil
+}
+
+// loadShape returns the polygons of an inline GeoJSON geometry and of a
+// GeoJSON file, nil if neither is set.
+func loadShape(geometry map[string]any, path, dir string) (geo.MultiPolygon, error) {
+	var shape geo.MultiPolygon
+	if geometry != nil {
+		data, err := json.Marshal(geometry)
+		if err != nil {
+			return nil, fmt.Errorf("cannot encode geometry: %w", err)
+		}
+		m, err := geo.ParseGeoJSON(data)
+		if err != nil {
+			return nil, fmt.Errorf("geometry: %w", err)
+		}
+		shape = append(shape, m...)
+	}
+	if path != "" {
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(dir, path)
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read GeoJSON: %w", err)
+		}
+		m, err := geo.ParseGeoJSON(data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		shape = append(shape, m...)
+	}
+	return shape, nil
+}
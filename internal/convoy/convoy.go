@@ -0,0 +1,58 @@
+package convoy
+
+import (
+	"time"
+
+	"droneops-sim/internal/geo"
+	"droneops-sim/internal/telemetry"
+)
+
+// Step moves an en-route convoy along its route for dt, passing through as
+// many waypoints as the distance allows. Reaching the last waypoint marks the
+// convoy arrived. It returns the distance travelled in meters.
+func (c *Convoy) Step(dt time.Duration) float64 {
+	if c.Status != StatusEnRoute {
+		return 0
+	}
+	remaining := c.SpeedMPS * dt.Seconds()
+	travelled := 0.0
+	for c.Waypoint < len(c.Route) {
+		target := c.Route[c.Waypoint]
+		dist := geo.Distance(c.Position.Lat, c.Position.Lon, target.Lat, target.Lon)
+		if dist > remaining {
+			bearing := geo.Bearing(c.Position.Lat, c.Position.Lon, target.Lat, target.Lon)
+			c.Position.Lat, c.Position.Lon = geo.Destination(c.Position.Lat, c.Position.Lon, bearing, remaining)
+			return travelled + remaining
+		}
+		c.Position.Lat, c.Position.Lon = target.Lat, target.Lon
+		remaining -= dist
+		travelled += dist
+		c.Waypoint++
+	}
+	c.Status = StatusArrived
+	return travelled
+}
+
+// Damage reduces the convoy's health, destroying it at zero. It reports
+// whether this damage destroyed the convoy.
+func (c *Convoy) Damage(amount float64) bool {
+	if c.Status != StatusEnRoute || amount <= 0 {
+		return false
+	}
+	c.Health -= amount
+	if c.Health > 0 {
+		return false
+	}
+	c.Health = 0
+	c.Status = StatusDestroyed
+	return true
+}
+
+// Destination returns the last point of the route, or the current position
+// for a convoy without a route.
+func (c *Convoy) Destination() telemetry.Position {
+	if len(c.Route) == 0 {
+		return c.Position
+	}
+	return c.Route[len(c.Route)-1]
+}
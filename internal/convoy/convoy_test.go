@@ -0,0 +1,51 @@
+package convoy
+
+import (
+	"testing"
+	"time"
+
+	"droneops-sim/internal/telemetry"
+)
+
+func TestStepFollowsRouteAndArrives(t *testing.T) {
+	c := &Convoy{
+		ID:       "c",
+		SpeedMPS: 100,
+		Route:    []telemetry.Position{{Lat: 0.001}, {Lat: 0.001, Lon: 0.001}},
+		Health:   100,
+		Status:   StatusEnRoute,
+	}
+	if d := c.Step(time.Second); d != 100 {
+		t.Fatalf("expected 100m travelled, got %f", d)
+	}
+	if c.Waypoint != 0 || c.Position.Lat <= 0 || c.Position.Lat >= 0.001 {
+		t.Fatalf("expected convoy between start and first waypoint, got %+v", c)
+	}
+	c.Step(time.Second)
+	if c.Waypoint != 1 || c.Position.Lon <= 0 {
+		t.Fatalf("expected convoy past the first waypoint, got %+v", c)
+	}
+	c.Step(time.Second)
+	if c.Status != StatusArrived || c.Position != c.Destination() {
+		t.Fatalf("expected convoy at destination, got %+v", c)
+	}
+	if d := c.Step(time.Second); d != 0 {
+		t.Fatalf("expected arrived convoy to stay put, moved %f", d)
+	}
+}
+
+func TestDamageDestroysConvoy(t *testing.T) {
+	c := &Convoy{Health: 10, Status: StatusEnRoute, Route: []telemetry.Position{{Lat: 1}}, SpeedMPS: 10}
+	if c.Damage(4) || c.Health != 6 {
+		t.Fatalf("expected health 6, got %+v", c)
+	}
+	if !c.Damage(10) || c.Health != 0 || c.Status != StatusDestroyed {
+		t.Fatalf("expected destroyed convoy, got %+v", c)
+	}
+	if c.Damage(1) {
+		t.Fatalf("expected destroyed convoy to ignore further damage")
+	}
+	if c.Step(time.Second) != 0 {
+		t.Fatalf("expected destroyed convoy not to move")
+	}
+}
@@ -0,0 +1,49 @@
+// Package convoy models friendly ground convoys that drones escort along a
+// route of waypoints.
+package convoy
+
+import (
+	"time"
+
+	"droneops-sim/internal/telemetry"
+)
+
+// Status tracks a convoy through its journey.
+type Status string
+
+const (
+	// StatusEnRoute indicates the convoy is travelling along its route.
+	StatusEnRoute Status = "en_route"
+	// StatusArrived indicates the convoy reached its destination.
+	StatusArrived Status = "arrived"
+	// StatusDestroyed indicates the convoy lost all health before arriving.
+	StatusDestroyed Status = "destroyed"
+)
+
+// Convoy is a group of friendly ground vehicles moving along Route at
+// SpeedMPS. Waypoint is the index of the next route point to reach and the
+// last route point is the destination.
+type Convoy struct {
+	ID       string
+	Position telemetry.Position
+	SpeedMPS float64
+	Route    []telemetry.Position
+	Waypoint int
+	Health   float64
+	Status   Status
+}
+
+// StateRow records a convoy's position and health for one tick.
+type StateRow struct {
+	ClusterID  string    `json:"cluster_id"`
+	ConvoyID   string    `json:"convoy_id"`
+	Status     Status    `json:"status"`
+	Lat        float64   `json:"lat"`
+	Lon        float64   `json:"lon"`
+	Alt        float64   `json:"alt"`
+	SpeedMPS   float64   `json:"speed_mps"`
+	HeadingDeg float64   `json:"heading_deg"`
+	Health     float64   `json:"health"`
+	Waypoint   int       `json:"waypoint"`
+	Timestamp  time.Time `json:"ts"`
+}
@@ -0,0 +1,153 @@
+package coverage
+
+import (
+	"math"
+	"time"
+
+	"droneops-sim/internal/geo"
+	"droneops-sim/internal/telemetry"
+)
+
+// MaxCellsPerSide bounds the resolution of grids over large regions.
+const MaxCellsPerSide = 200
+
+// New creates an unseen grid over the region of radiusM around center. Cells
+// are cellM wide, coarsened if the region would need more than
+// MaxCellsPerSide cells per side.
+func New(missionID string, center telemetry.Position, radiusM, cellM float64) *Grid {
+	cellM = math.Max(cellM, 2*radiusM/MaxCellsPerSide)
+	size := 1
+	if cellM > 0 {
+		size = max(int(math.Ceil(2*radiusM/cellM)), 1)
+	}
+	g := &Grid{
+		MissionID: missionID,
+		Center:    center,
+		RadiusM:   radiusM,
+		CellM:     cellM,
+		Size:      size,
+		lastSeen:  make([]time.Time, size*size),
+		inside:    make([]bool, size*size),
+	}
+	for i := range g.inside {
+		x, y := g.cellCenter(i)
+		g.inside[i] = math.Hypot(x, y) <= radiusM
+	}
+	return g
+}
+
+// Clip leaves out the cells whose centers lie outside the area of contains,
+// for regions bounded by polygons rather than the grid's circle.
+func (g *Grid) Clip(contains func(lat, lon float64) bool) {
+	for i, in := range g.inside {
+		if !in {
+			continue
+		}
+		x, y := g.cellCenter(i)
+		lat, lon, _ := geo.FromENU(g.Center.Lat, g.Center.Lon, 0, x, y, 0)
+		g.inside[i] = contains(lat, lon)
+	}
+}
+
+// Mark records the cells along the track from prev to pos as seen at t. A
+// cell is seen when its center lies within half a swath of the track; swaths
+// narrower than a cell's diagonal still mark the cells the track passes
+// through.
+func (g *Grid) Mark(prev, pos telemetry.Position, swathM float64, t time.Time) {
+	half := math.Max(swathM, g.CellM*math.Sqrt2) / 2
+	x1, y1 := g.local(prev)
+	x2, y2 := g.local(pos)
+	c0, c1 := g.index(math.Min(x1, x2)-half), g.index(math.Max(x1, x2)+half)
+	r0, r1 := g.index(math.Min(y1, y2)-half), g.index(math.Max(y1, y2)+half)
+	for row := r0; row <= r1; row++ {
+		for col := c0; col <= c1; col++ {
+			i := row*g.Size + col
+			if !g.inside[i] {
+				continue
+			}
+			x, y := g.cellCenter(i)
+			if segmentDistance(x, y, x1, y1, x2, y2) <= half {
+				g.lastSeen[i] = t
+			}
+		}
+	}
+}
+
+// Stats returns the share of cells seen and the mean and longest time since
+// the seen cells were last visited, as of now.
+func (g *Grid) Stats(now time.Time) telemetry.CoverageStat {
+	st := telemetry.CoverageStat{MissionID: g.MissionID}
+	total, seen := 0, 0
+	var sum float64
+	for i, in := range g.inside {
+		if !in {
+			continue
+		}
+		total++
+		if g.lastSeen[i].IsZero() {
+			continue
+		}
+		seen++
+		age := now.Sub(g.lastSeen[i]).Seconds()
+		sum += age
+		st.MaxRevisitS = math.Max(st.MaxRevisitS, age)
+	}
+	if total > 0 {
+		st.CoveragePct = 100 * float64(seen) / float64(total)
+	}
+	if seen > 0 {
+		st.MeanRevisitS = sum / float64(seen)
+	}
+	return st
+}
+
+// Heatmap returns the cells inside the region with the time since each was
+// last seen, as of now.
+func (g *Grid) Heatmap(now time.Time) Heatmap {
+	h := Heatmap{MissionID: g.MissionID, CellM: g.CellM, CoveragePct: g.Stats(now).CoveragePct}
+	for i, in := range g.inside {
+		if !in {
+			continue
+		}
+		x, y := g.cellCenter(i)
+		var c Cell
+		c.Lat, c.Lon, _ = geo.FromENU(g.Center.Lat, g.Center.Lon, 0, x, y, 0)
+		if !g.lastSeen[i].IsZero() {
+			c.Seen = true
+			c.RevisitS = now.Sub(g.lastSeen[i]).Seconds()
+		}
+		h.Cells = append(h.Cells, c)
+	}
+	return h
+}
+
+// local returns pos in meters east and north of the grid center.
+func (g *Grid) local(pos telemetry.Position) (x, y float64) {
+	x, y, _ = geo.ToENU(g.Center.Lat, g.Center.Lon, 0, pos.Lat, pos.Lon, 0)
+	return x, y
+}
+
+// index returns the column or row containing the local coordinate v,
+// clamped to the grid.
+func (g *Grid) index(v float64) int {
+	i := int(math.Floor(v/g.CellM + float64(g.Size)/2))
+	return min(max(i, 0), g.Size-1)
+}
+
+// cellCenter returns the center of cell i in meters east and north of the
+// grid center.
+func (g *Grid) cellCenter(i int) (x, y float64) {
+	origin := -float64(g.Size) * g.CellM / 2
+	return origin + (float64(i%g.Size)+0.5)*g.CellM, origin + (float64(i/g.Size)+0.5)*g.CellM
+}
+
+// segmentDistance returns the distance of point (px, py) from the segment
+// (x1, y1)-(x2, y2).
+func segmentDistance(px, py, x1, y1, x2, y2 float64) float64 {
+	dx, dy := x2-x1, y2-y1
+	t := 0.0
+	if l := dx*dx + dy*dy; l > 0 {
+		t = math.Max(0, math.Min(1, ((px-x1)*dx+(py-y1)*dy)/l))
+	}
+	return math.Hypot(px-(x1+t*dx), py-(y1+t*dy))
+}
@@ -0,0 +1,52 @@
+package coverage
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"droneops-sim/internal/geo"
+	"droneops-sim/internal/telemetry"
+)
+
+func TestGridMarksTrackAndAges(t *testing.T) {
+	center := telemetry.Position{Lat: 48.2, Lon: 16.4}
+	g := New("m", center, 500, 100)
+	if g.Size != 10 {
+		t.Fatalf("expected 10 cells per side, got %d", g.Size)
+	}
+	start := time.Unix(0, 0)
+	var south, north telemetry.Position
+	south.Lat, south.Lon = geo.Offset(center.Lat, center.Lon, -500, 0)
+	north.Lat, north.Lon = geo.Offset(center.Lat, center.Lon, 500, 0)
+	g.Mark(south, north, 200, start)
+
+	st := g.Stats(start.Add(10 * time.Second))
+	if st.MissionID != "m" || st.CoveragePct <= 0 || st.CoveragePct >= 50 {
+		t.Fatalf("expected a strip of the region seen, got %+v", st)
+	}
+	if st.MeanRevisitS != 10 || st.MaxRevisitS != 10 {
+		t.Fatalf("expected cells last seen 10 s ago, got %+v", st)
+	}
+
+	h := g.Heatmap(start.Add(10 * time.Second))
+	seen := 0
+	for _, c := range h.Cells {
+		if c.Seen {
+			seen++
+			if geo.Distance(c.Lat, center.Lon, c.Lat, c.Lon) > 100 {
+				t.Fatalf("expected only cells along the track seen, got %+v", c)
+			}
+		}
+	}
+	if seen == 0 || math.Abs(100*float64(seen)/float64(len(h.Cells))-st.CoveragePct) > 1e-9 {
+		t.Fatalf("expected heatmap to match coverage %.1f%%, got %d of %d cells", st.CoveragePct, seen, len(h.Cells))
+	}
+}
+
+func TestGridCoarsensLargeRegions(t *testing.T) {
+	g := New("m", telemetry.Position{}, 50000, 10)
+	if g.Size != MaxCellsPerSide || g.CellM != 500 {
+		t.Fatalf("expected %d cells of 500 m, got %d of %.0f m", MaxCellsPerSide, g.Size, g.CellM)
+	}
+}
@@ -0,0 +1,38 @@
+// Package coverage records which parts of a search region drones have seen
+// and how long ago.
+package coverage
+
+import (
+	"time"
+
+	"droneops-sim/internal/telemetry"
+)
+
+// Grid divides a circular search region of RadiusM around Center into square
+// cells of CellM meters, Size cells per side. Only cells whose center lies in
+// the region count toward coverage.
+type Grid struct {
+	MissionID string
+	Center    telemetry.Position
+	RadiusM   float64
+	CellM     float64
+	Size      int
+	lastSeen  []time.Time // per cell, row by row from the south-west; zero until seen
+	inside    []bool
+}
+
+// Cell is one cell of a coverage heatmap.
+type Cell struct {
+	Lat      float64 `json:"lat"`
+	Lon      float64 `json:"lon"`
+	Seen     bool    `json:"seen"`
+	RevisitS float64 `json:"revisit_s,omitempty"` // Time since the cell was last seen
+}
+
+// Heatmap lists the cells of a grid inside its region.
+type Heatmap struct {
+	MissionID   string  `json:"mission_id"`
+	CellM       float64 `json:"cell_m"`
+	CoveragePct float64 `json:"coverage_pct"`
+	Cells       []Cell  `json:"cells"`
+}
@@ -7,17 +7,27 @@ import (
 
 	"github.com/google/uuid"
 
+	"droneops-sim/internal/geo"
 	"droneops-sim/internal/telemetry"
 )
 
-const nearDroneDistThreshold = 0.005 // degrees, ~500m
+const (
+	nearDroneDistThreshold = 500.0 // meters, drones closer than this are evaded
+	moveStep               = 100.0 // meters moved per tick when evading or closing in
+	wanderStep             = 50.0  // meters, largest north and east offset of a random step
+	maxSpawnAttempts       = 100   // draws for a point inside a polygon region
+)
 
 // Engine maintains and updates simulated enemy entities.
 type Engine struct {
-	regions   []telemetry.Region
-	Enemies   []*Enemy
-	rand      *rand.Rand
-	randFloat func() float64
+	regions    []telemetry.Region
+	Enemies    []*Enemy
+	rand       *rand.Rand
+	randFloat  func() float64
+	objectives map[string]Objective
+	targets    map[string]telemetry.Position
+	patrolIdx  map[string]int
+	retreats   map[string]telemetry.Position // Where enemies without a region began retreating
 }
 
 // NewEngine creates an engine with a given number of enemies per region.
@@ -34,6 +44,7 @@ func NewEngine(count int, regions []telemetry.Region, r *rand.Rand) *Engine {
 				Position:   randomPosition(r, reg),
 				Confidence: 100,
 				Region:     reg,
+				Group:      reg.Name,
 				Status:     EnemyActive,
 			}
 			e.Enemies = append(e.Enemies, en)
@@ -47,46 +58,53 @@ func randomType(r *rand.Rand) EnemyType {
 	return types[r.Intn(len(types))]
 }
 
+// randomPosition returns a random point of the region. Points of polygon
+// regions are drawn from the bounding circle until one falls inside, the
+// region's anchor if none does.
 func randomPosition(r *rand.Rand, region telemetry.Region) telemetry.Position {
-	angle := r.Float64() * 2 * math.Pi
-	dist := r.Float64() * region.RadiusKM * 1000
-	dLat := (dist * math.Cos(angle)) / 111000
-	dLon := (dist * math.Sin(angle)) / (111000 * math.Cos(region.CenterLat*math.Pi/180))
-	return telemetry.Position{Lat: region.CenterLat + dLat, Lon: region.CenterLon + dLon, Alt: 0}
+	for i := 0; ; i++ {
+		bearing := r.Float64() * 360
+		dist := r.Float64() * region.RadiusKM * 1000
+		lat, lon := geo.Destination(region.CenterLat, region.CenterLon, bearing, dist)
+		if i == maxSpawnAttempts {
+			lat, lon = region.Anchor()
+		}
+		if region.Shape == nil || region.Contains(lat, lon) || i == maxSpawnAttempts {
+			return telemetry.Position{Lat: lat, Lon: lon, Alt: 0}
+		}
+	}
 }
 
 func randomStep(r *rand.Rand, pos telemetry.Position) telemetry.Position {
-	dLat := r.Float64()*0.001 - 0.0005
-	dLon := r.Float64()*0.001 - 0.0005
-	return telemetry.Position{Lat: pos.Lat + dLat, Lon: pos.Lon + dLon, Alt: pos.Alt}
+	north := (r.Float64()*2 - 1) * wanderStep
+	east := (r.Float64()*2 - 1) * wanderStep
+	lat, lon := geo.Offset(pos.Lat, pos.Lon, north, east)
+	return telemetry.Position{Lat: lat, Lon: lon, Alt: pos.Alt}
 }
 
+// distance returns the ground distance between a and b in meters.
 func distance(a, b telemetry.Position) float64 {
-	dLat := a.Lat - b.Lat
-	dLon := a.Lon - b.Lon
-	return math.Sqrt(dLat*dLat + dLon*dLon)
+	return geo.Distance(a.Lat, a.Lon, b.Lat, b.Lon)
+}
+
+// step returns the point dist meters from pos along bearing, keeping its altitude.
+func step(pos telemetry.Position, bearing, dist float64) telemetry.Position {
+	lat, lon := geo.Destination(pos.Lat, pos.Lon, bearing, dist)
+	return telemetry.Position{Lat: lat, Lon: lon, Alt: pos.Alt}
 }
 
 func moveAway(r *rand.Rand, pos, threat telemetry.Position) telemetry.Position {
-	vecLat := pos.Lat - threat.Lat
-	vecLon := pos.Lon - threat.Lon
-	norm := math.Sqrt(vecLat*vecLat + vecLon*vecLon)
-	if norm == 0 {
+	if distance(pos, threat) == 0 {
 		return randomStep(r, pos)
 	}
-	factor := 0.001 / norm
-	return telemetry.Position{Lat: pos.Lat + vecLat*factor, Lon: pos.Lon + vecLon*factor, Alt: pos.Alt}
+	return step(pos, geo.Bearing(threat.Lat, threat.Lon, pos.Lat, pos.Lon), moveStep)
 }
 
 func moveTowards(pos, target telemetry.Position) telemetry.Position {
-	vecLat := target.Lat - pos.Lat
-	vecLon := target.Lon - pos.Lon
-	norm := math.Sqrt(vecLat*vecLat + vecLon*vecLon)
-	if norm == 0 {
+	if distance(pos, target) == 0 {
 		return pos
 	}
-	factor := 0.001 / norm
-	return telemetry.Position{Lat: pos.Lat + vecLat*factor, Lon: pos.Lon + vecLon*factor, Alt: pos.Alt}
+	return step(pos, geo.Bearing(pos.Lat, pos.Lon, target.Lat, target.Lon), moveStep)
 }
 
 func nearestDrone(pos telemetry.Position, drones []*telemetry.Drone) (*telemetry.Drone, float64) {
@@ -139,16 +157,15 @@ func (e *Engine) pursueAnotherEnemy(en *Enemy) bool {
 }
 
 func (e *Engine) handleRegionBounds(en *Enemy) {
-	if en.Region.RadiusKM > 0 {
-		center := telemetry.Position{Lat: en.Region.CenterLat, Lon: en.Region.CenterLon}
-		if distance(en.Position, center) > en.Region.RadiusKM/111 {
-			en.Position = randomPosition(e.rand, en.Region)
-		}
+	if en.Region.RadiusKM > 0 && !en.Region.Contains(en.Position.Lat, en.Position.Lon) {
+		en.Position = randomPosition(e.rand, en.Region)
 	}
 }
 
 // Step updates enemies based on drone positions and tactics and returns
-// IDs of any enemies removed due to expiration or inactivity.
+// IDs of any enemies removed due to expiration or inactivity. Enemies with an
+// executable objective follow it instead of their default tactics and are not
+// confined to their region.
 func (e *Engine) Step(drones []*telemetry.Drone) []string {
 	if e.randFloat == nil {
 		e.randFloat = e.rand.Float64
@@ -164,6 +181,9 @@ func (e *Engine) Step(drones []*telemetry.Drone) []string {
 	}
 	e.Enemies = filtered
 	for _, en := range e.Enemies {
+		if obj, ok := e.ObjectiveFor(en); ok && e.pursueObjective(en, obj) {
+			continue
+		}
 		handled := e.respondToNearbyDrone(en, drones)
 		if !handled {
 			handled = e.pursueAnotherEnemy(en)
@@ -4,6 +4,7 @@ import (
 	"math/rand"
 	"testing"
 
+	"droneops-sim/internal/geo"
 	"droneops-sim/internal/telemetry"
 )
 
@@ -29,10 +30,10 @@ func TestEngine_SpawnMultipleRegions(t *testing.T) {
 	}
 	foundA, foundB := false, false
 	for _, e := range eng.Enemies {
-		if distance(e.Position, telemetry.Position{Lat: regions[0].CenterLat, Lon: regions[0].CenterLon}) < regions[0].RadiusKM/111 {
+		if distance(e.Position, telemetry.Position{Lat: regions[0].CenterLat, Lon: regions[0].CenterLon}) < regions[0].RadiusKM*1000 {
 			foundA = true
 		}
-		if distance(e.Position, telemetry.Position{Lat: regions[1].CenterLat, Lon: regions[1].CenterLon}) < regions[1].RadiusKM/111 {
+		if distance(e.Position, telemetry.Position{Lat: regions[1].CenterLat, Lon: regions[1].CenterLon}) < regions[1].RadiusKM*1000 {
 			foundB = true
 		}
 	}
@@ -60,7 +61,7 @@ func TestEngine_HandleRegionBounds(t *testing.T) {
 	eng := &Engine{regions: []telemetry.Region{region}, Enemies: []*Enemy{en}, rand: rand.New(rand.NewSource(1)), randFloat: rand.Float64}
 	_ = eng.Step(nil)
 	center := telemetry.Position{Lat: region.CenterLat, Lon: region.CenterLon}
-	if distance(en.Position, center) > region.RadiusKM/111 {
+	if distance(en.Position, center) > region.RadiusKM*1000 {
 		t.Fatalf("expected enemy to be within region bounds")
 	}
 }
@@ -93,3 +94,22 @@ func TestEngine_RemoveInactive(t *testing.T) {
 		t.Fatalf("expected only active enemy to remain")
 	}
 }
+
+func TestEngine_PolygonRegion(t *testing.T) {
+	// An L-shaped region: its bounding circle covers the empty north-east quarter
+	shape := geo.MultiPolygon{{geo.Ring{{Lat: 0, Lon: 0}, {Lat: 0, Lon: 0.02}, {Lat: 0.01, Lon: 0.02}, {Lat: 0.01, Lon: 0.01}, {Lat: 0.02, Lon: 0.01}, {Lat: 0.02, Lon: 0}}}}
+	lat, lon, r := shape.BoundingCircle()
+	region := telemetry.Region{Name: "l", CenterLat: lat, CenterLon: lon, RadiusKM: r / 1000, Shape: shape}
+	eng := NewEngine(50, []telemetry.Region{region}, rand.New(rand.NewSource(1)))
+	for _, en := range eng.Enemies {
+		if !region.Contains(en.Position.Lat, en.Position.Lon) {
+			t.Fatalf("expected enemies spawned inside the polygon, got %+v", en.Position)
+		}
+	}
+	en := eng.Enemies[0]
+	en.Position = telemetry.Position{Lat: 0.015, Lon: 0.015} // Within the circle, outside the polygon
+	eng.handleRegionBounds(en)
+	if !region.Contains(en.Position.Lat, en.Position.Lon) {
+		t.Fatalf("expected enemy returned into the polygon, got %+v", en.Position)
+	}
+}
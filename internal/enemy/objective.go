@@ -0,0 +1,154 @@
+package enemy
+
+import (
+	"math"
+
+	"droneops-sim/internal/geo"
+	"droneops-sim/internal/telemetry"
+)
+
+// Action identifies the behaviour an enemy follows while an objective is active.
+type Action string
+
+const (
+	// ActionAttack moves straight towards the named target.
+	ActionAttack Action = "attack"
+	// ActionHarass orbits the named target at a standoff distance.
+	ActionHarass Action = "harass"
+	// ActionRetreat moves away from the enemy's region until it has left it.
+	// Enemies without a region retreat a fixed distance from where they
+	// were when the retreat began.
+	ActionRetreat Action = "retreat"
+	// ActionPatrol follows a route of waypoints in a loop.
+	ActionPatrol Action = "patrol"
+)
+
+// AllEnemies is the objective key that applies to every enemy without a more
+// specific objective.
+const AllEnemies = "*"
+
+const (
+	objectiveStep       = moveStep // meters moved per tick, matching evasive moves
+	harassStandoff      = 300.0    // meters
+	retreatRadiusFactor = 1.5      // multiple of the region radius treated as "out"
+	retreatDistance     = 2000.0   // meters retreated by enemies without a region
+)
+
+// Objective directs an enemy or a group of enemies towards a behaviour.
+type Objective struct {
+	Action Action
+	Target string
+	Route  []telemetry.Position
+}
+
+// SetObjective assigns an objective to an enemy ID, an enemy group or
+// AllEnemies. Lookups prefer the enemy ID, then its group, then AllEnemies.
+func (e *Engine) SetObjective(id string, obj Objective) {
+	if e.objectives == nil {
+		e.objectives = make(map[string]Objective)
+	}
+	e.objectives[id] = obj
+}
+
+// ClearObjectives removes all objectives, returning enemies to their default tactics.
+func (e *Engine) ClearObjectives() {
+	e.objectives = nil
+	e.patrolIdx = nil
+	e.retreats = nil
+}
+
+// SetTarget registers or moves a named position that objectives may reference.
+func (e *Engine) SetTarget(name string, pos telemetry.Position) {
+	if e.targets == nil {
+		e.targets = make(map[string]telemetry.Position)
+	}
+	e.targets[name] = pos
+}
+
+// ObjectiveFor returns the objective that applies to the given enemy.
+func (e *Engine) ObjectiveFor(en *Enemy) (Objective, bool) {
+	for _, key := range []string{en.ID, en.Group, AllEnemies} {
+		if key == "" {
+			continue
+		}
+		if obj, ok := e.objectives[key]; ok {
+			return obj, true
+		}
+	}
+	return Objective{}, false
+}
+
+// pursueObjective moves the enemy according to its objective. It returns false
+// when the objective cannot be executed, e.g. because the target is unknown.
+func (e *Engine) pursueObjective(en *Enemy, obj Objective) bool {
+	switch obj.Action {
+	case ActionAttack:
+		target, ok := e.targets[obj.Target]
+		if !ok {
+			return false
+		}
+		if distance(en.Position, target) <= objectiveStep {
+			en.Position = telemetry.Position{Lat: target.Lat, Lon: target.Lon, Alt: en.Position.Alt}
+			return true
+		}
+		en.Position = moveTowards(en.Position, target)
+		return true
+	case ActionHarass:
+		target, ok := e.targets[obj.Target]
+		if !ok {
+			return false
+		}
+		en.Position = orbit(en.Position, target, harassStandoff)
+		return true
+	case ActionRetreat:
+		center := telemetry.Position{Lat: en.Region.CenterLat, Lon: en.Region.CenterLon}
+		out := retreatRadiusFactor * en.Region.RadiusKM * 1000
+		if en.Region.RadiusKM <= 0 {
+			if e.retreats == nil {
+				e.retreats = make(map[string]telemetry.Position)
+			}
+			if _, ok := e.retreats[en.ID]; !ok {
+				e.retreats[en.ID] = en.Position
+			}
+			center, out = e.retreats[en.ID], retreatDistance
+		}
+		if distance(en.Position, center) > out {
+			return true
+		}
+		en.Position = moveAway(e.rand, en.Position, center)
+		return true
+	case ActionPatrol:
+		if len(obj.Route) == 0 {
+			return false
+		}
+		if e.patrolIdx == nil {
+			e.patrolIdx = make(map[string]int)
+		}
+		idx := e.patrolIdx[en.ID] % len(obj.Route)
+		wp := obj.Route[idx]
+		if distance(en.Position, wp) <= objectiveStep {
+			en.Position = telemetry.Position{Lat: wp.Lat, Lon: wp.Lon, Alt: en.Position.Alt}
+			e.patrolIdx[en.ID] = (idx + 1) % len(obj.Route)
+			return true
+		}
+		en.Position = moveTowards(en.Position, wp)
+		return true
+	}
+	return false
+}
+
+// orbit closes to the standoff ring around target and then circles it.
+func orbit(pos, target telemetry.Position, standoff float64) telemetry.Position {
+	dist := distance(pos, target)
+	if dist > standoff+objectiveStep {
+		return moveTowards(pos, target)
+	}
+	if dist < standoff-objectiveStep {
+		if dist == 0 {
+			return step(telemetry.Position{Lat: target.Lat, Lon: target.Lon, Alt: pos.Alt}, 0, standoff)
+		}
+		return step(pos, geo.Bearing(target.Lat, target.Lon, pos.Lat, pos.Lon), objectiveStep)
+	}
+	bearing := geo.Bearing(target.Lat, target.Lon, pos.Lat, pos.Lon) - objectiveStep/standoff*180/math.Pi
+	return step(telemetry.Position{Lat: target.Lat, Lon: target.Lon, Alt: pos.Alt}, bearing, standoff)
+}
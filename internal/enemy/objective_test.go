@@ -0,0 +1,111 @@
+package enemy
+
+import (
+	"math/rand"
+	"testing"
+
+	"droneops-sim/internal/telemetry"
+)
+
+func newObjectiveEngine(en *Enemy) *Engine {
+	return &Engine{regions: []telemetry.Region{en.Region}, Enemies: []*Enemy{en}, rand: rand.New(rand.NewSource(1)), randFloat: func() float64 { return 0.9 }}
+}
+
+func TestObjectiveAttackMovesTowardsTarget(t *testing.T) {
+	region := telemetry.Region{Name: "r", CenterLat: 0, CenterLon: 0, RadiusKM: 1}
+	en := &Enemy{ID: "e", Group: "raiders", Position: telemetry.Position{}, Region: region, Status: EnemyActive}
+	eng := newObjectiveEngine(en)
+	target := telemetry.Position{Lat: 0.1, Lon: 0.1}
+	eng.SetTarget("base", target)
+	eng.SetObjective("raiders", Objective{Action: ActionAttack, Target: "base"})
+	before := distance(en.Position, target)
+	eng.Step(nil)
+	if after := distance(en.Position, target); after >= before {
+		t.Fatalf("expected enemy to close on target, %f -> %f", before, after)
+	}
+	// the target lies outside the region, attackers must not be pulled back
+	for i := 0; i < 200; i++ {
+		eng.Step(nil)
+	}
+	if en.Position.Lat != target.Lat || en.Position.Lon != target.Lon {
+		t.Fatalf("expected enemy to reach target, got %+v", en.Position)
+	}
+}
+
+func TestObjectiveHarassKeepsStandoff(t *testing.T) {
+	region := telemetry.Region{CenterLat: 0, CenterLon: 0, RadiusKM: 5}
+	en := &Enemy{ID: "e", Position: telemetry.Position{Lat: 0.01}, Region: region, Status: EnemyActive}
+	eng := newObjectiveEngine(en)
+	eng.SetTarget("convoy", telemetry.Position{})
+	eng.SetObjective("e", Objective{Action: ActionHarass, Target: "convoy"})
+	for i := 0; i < 20; i++ {
+		eng.Step(nil)
+	}
+	start := en.Position
+	eng.Step(nil)
+	d := distance(en.Position, telemetry.Position{})
+	if d < harassStandoff-objectiveStep || d > harassStandoff+objectiveStep {
+		t.Fatalf("expected enemy at standoff distance, got %f", d)
+	}
+	if en.Position == start {
+		t.Fatalf("expected enemy to keep orbiting")
+	}
+}
+
+func TestObjectiveRetreatLeavesRegion(t *testing.T) {
+	region := telemetry.Region{CenterLat: 0, CenterLon: 0, RadiusKM: 1}
+	en := &Enemy{ID: "e", Position: telemetry.Position{Lat: 0.001}, Region: region, Status: EnemyActive}
+	eng := newObjectiveEngine(en)
+	eng.SetObjective(AllEnemies, Objective{Action: ActionRetreat})
+	for i := 0; i < 50; i++ {
+		eng.Step(nil)
+	}
+	if distance(en.Position, telemetry.Position{}) <= region.RadiusKM*1000 {
+		t.Fatalf("expected enemy to leave region, got %+v", en.Position)
+	}
+}
+
+func TestObjectiveRetreatWithoutRegion(t *testing.T) {
+	start := telemetry.Position{Lat: 48.2, Lon: 16.4}
+	en := &Enemy{ID: "e", Position: start, Status: EnemyActive} // Spawned on a point, without a region
+	eng := &Engine{Enemies: []*Enemy{en}, rand: rand.New(rand.NewSource(1)), randFloat: func() float64 { return 0.9 }}
+	eng.SetObjective(AllEnemies, Objective{Action: ActionRetreat})
+	for i := 0; i < 50; i++ {
+		eng.Step(nil)
+	}
+	if d := distance(en.Position, start); d <= retreatDistance || d > retreatDistance+2*objectiveStep {
+		t.Fatalf("expected enemy to retreat %v m from where it started, got %.0f m", retreatDistance, d)
+	}
+}
+
+func TestObjectivePatrolFollowsRoute(t *testing.T) {
+	region := telemetry.Region{CenterLat: 0, CenterLon: 0, RadiusKM: 1}
+	en := &Enemy{ID: "e", Position: telemetry.Position{}, Region: region, Status: EnemyActive}
+	eng := newObjectiveEngine(en)
+	route := []telemetry.Position{{Lat: 0.0015}, {Lat: 0.0015, Lon: 0.0015}}
+	eng.SetObjective("e", Objective{Action: ActionPatrol, Route: route})
+	eng.Step(nil)
+	eng.Step(nil)
+	if en.Position.Lat != route[0].Lat || en.Position.Lon != route[0].Lon {
+		t.Fatalf("expected enemy at first waypoint, got %+v", en.Position)
+	}
+	eng.Step(nil)
+	if en.Position.Lon <= 0 {
+		t.Fatalf("expected enemy to head to second waypoint, got %+v", en.Position)
+	}
+}
+
+func TestObjectiveUnknownTargetFallsBack(t *testing.T) {
+	region := telemetry.Region{CenterLat: 0, CenterLon: 0, RadiusKM: 1}
+	en := &Enemy{ID: "e", Position: telemetry.Position{}, Region: region, Status: EnemyActive}
+	eng := newObjectiveEngine(en)
+	eng.SetObjective("e", Objective{Action: ActionAttack, Target: "missing"})
+	eng.Step(nil)
+	if en.Position == (telemetry.Position{}) {
+		t.Fatalf("expected default tactics to move the enemy")
+	}
+	eng.ClearObjectives()
+	if _, ok := eng.ObjectiveFor(en); ok {
+		t.Fatalf("expected objectives to be cleared")
+	}
+}
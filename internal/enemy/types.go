@@ -32,6 +32,7 @@ type Enemy struct {
 	Position   telemetry.Position
 	Confidence float64
 	Region     telemetry.Region
+	Group      string
 	Status     EnemyStatus
 }
 
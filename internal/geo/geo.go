@@ -0,0 +1,110 @@
+// Package geo implements the geodesy shared by the simulator on a spherical
+// earth: great-circle distances and bearings, destination points, metric
+// offsets and local east-north-up and north-east-down frames. Distances and
+// altitudes are in meters, angles in degrees; longitudes are kept within
+// [-180, 180).
+package geo
+
+import "math"
+
+// EarthRadiusM is the mean earth radius.
+const EarthRadiusM = 6371000.0
+
+const rad = math.Pi / 180
+
+// Distance returns the great-circle distance between two points using the
+// haversine formula.
+func Distance(lat1, lon1, lat2, lon2 float64) float64 {
+	dLat := (lat2 - lat1) * rad
+	dLon := (lon2 - lon1) * rad
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1*rad)*math.Cos(lat2*rad)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * EarthRadiusM * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+}
+
+// Bearing returns the initial great-circle bearing from the first point to
+// the second, in degrees from north within [0, 360).
+func Bearing(lat1, lon1, lat2, lon2 float64) float64 {
+	dLon := (lon2 - lon1) * rad
+	y := math.Sin(dLon) * math.Cos(lat2*rad)
+	x := math.Cos(lat1*rad)*math.Sin(lat2*rad) - math.Sin(lat1*rad)*math.Cos(lat2*rad)*math.Cos(dLon)
+	return math.Mod(math.Atan2(y, x)/rad+360, 360)
+}
+
+// Destination returns the point dist meters from lat, lon along the great
+// circle starting at bearing.
+func Destination(lat, lon, bearing, dist float64) (float64, float64) {
+	if dist == 0 {
+		return lat, lon
+	}
+	d := dist / EarthRadiusM
+	phi, theta := lat*rad, bearing*rad
+	phi2 := math.Asin(math.Sin(phi)*math.Cos(d) + math.Cos(phi)*math.Sin(d)*math.Cos(theta))
+	dLon := math.Atan2(math.Sin(theta)*math.Sin(d)*math.Cos(phi), math.Cos(d)-math.Sin(phi)*math.Sin(phi2))
+	return phi2 / rad, NormalizeLon(lon + dLon/rad)
+}
+
+// Offset returns the point north and east meters from lat, lon, negative
+// values pointing south and west.
+func Offset(lat, lon, north, east float64) (float64, float64) {
+	return Destination(lat, lon, math.Atan2(east, north)/rad, math.Hypot(north, east))
+}
+
+// NormalizeLon wraps a longitude into [-180, 180).
+func NormalizeLon(lon float64) float64 {
+	if lon >= -180 && lon < 180 {
+		return lon
+	}
+	lon = math.Mod(lon+180, 360)
+	if lon < 0 {
+		lon += 360
+	}
+	return lon - 180
+}
+
+// ToENU returns the position of lat, lon, alt in the local east-north-up
+// frame tangent to the sphere at the reference point.
+func ToENU(refLat, refLon, refAlt, lat, lon, alt float64) (east, north, up float64) {
+	x, y, z := ecef(lat, lon, alt)
+	rx, ry, rz := ecef(refLat, refLon, refAlt)
+	dx, dy, dz := x-rx, y-ry, z-rz
+	sinLat, cosLat := math.Sincos(refLat * rad)
+	sinLon, cosLon := math.Sincos(refLon * rad)
+	east = -sinLon*dx + cosLon*dy
+	north = -sinLat*cosLon*dx - sinLat*sinLon*dy + cosLat*dz
+	up = cosLat*cosLon*dx + cosLat*sinLon*dy + sinLat*dz
+	return east, north, up
+}
+
+// FromENU returns the position of a point given in the local east-north-up
+// frame at the reference point.
+func FromENU(refLat, refLon, refAlt, east, north, up float64) (lat, lon, alt float64) {
+	sinLat, cosLat := math.Sincos(refLat * rad)
+	sinLon, cosLon := math.Sincos(refLon * rad)
+	rx, ry, rz := ecef(refLat, refLon, refAlt)
+	x := rx - sinLon*east - sinLat*cosLon*north + cosLat*cosLon*up
+	y := ry + cosLon*east - sinLat*sinLon*north + cosLat*sinLon*up
+	z := rz + cosLat*north + sinLat*up
+	r := math.Sqrt(x*x + y*y + z*z)
+	return math.Asin(z/r) / rad, NormalizeLon(math.Atan2(y, x) / rad), r - EarthRadiusM
+}
+
+// ToNED returns the position of lat, lon, alt in the local north-east-down
+// frame at the reference point.
+func ToNED(refLat, refLon, refAlt, lat, lon, alt float64) (north, east, down float64) {
+	east, north, up := ToENU(refLat, refLon, refAlt, lat, lon, alt)
+	return north, east, -up
+}
+
+// FromNED returns the position of a point given in the local
+// north-east-down frame at the reference point.
+func FromNED(refLat, refLon, refAlt, north, east, down float64) (lat, lon, alt float64) {
+	return FromENU(refLat, refLon, refAlt, east, north, -down)
+}
+
+// ecef returns the earth-centered, earth-fixed coordinates of a point.
+func ecef(lat, lon, alt float64) (x, y, z float64) {
+	sinLat, cosLat := math.Sincos(lat * rad)
+	sinLon, cosLon := math.Sincos(lon * rad)
+	r := EarthRadiusM + alt
+	return r * cosLat * cosLon, r * cosLat * sinLon, r * sinLat
+}
@@ -0,0 +1,86 @@
+package geo
+
+import (
+	"math"
+	"testing"
+)
+
+func near(a, b, tol float64) bool { return math.Abs(a-b) <= tol }
+
+func TestDistanceAndBearing(t *testing.T) {
+	if d := Distance(0, 0, 1, 0); !near(d, EarthRadiusM*math.Pi/180, 1e-6) {
+		t.Fatalf("expected a degree of latitude to be %.1f m, got %.1f", EarthRadiusM*math.Pi/180, d)
+	}
+	if d := Distance(48.2, 16.4, 48.2, 16.4); d != 0 {
+		t.Fatalf("expected zero distance, got %f", d)
+	}
+	for _, tc := range []struct {
+		lat, lon, want float64
+	}{{1, 0, 0}, {0, 1, 90}, {-1, 0, 180}, {0, -1, 270}} {
+		if b := Bearing(0, 0, tc.lat, tc.lon); !near(b, tc.want, 1e-9) {
+			t.Errorf("bearing to (%v, %v): expected %v, got %v", tc.lat, tc.lon, tc.want, b)
+		}
+	}
+}
+
+func TestHighLatitude(t *testing.T) {
+	// A degree of longitude shrinks with the cosine of the latitude
+	d := Distance(78.2, 15.6, 78.2, 16.6)
+	if want := EarthRadiusM * math.Pi / 180 * math.Cos(78.2*math.Pi/180); !near(d, want, 5) {
+		t.Fatalf("expected %.1f m per degree of longitude at 78.2N, got %.1f", want, d)
+	}
+	for _, lat := range []float64{78.2, 89.9, -89.9} {
+		lat2, lon2 := Offset(lat, 15.6, 300, -400)
+		if got := Distance(lat, 15.6, lat2, lon2); !near(got, 500, 1e-6) {
+			t.Errorf("offset at %v: expected 500 m away, got %f", lat, got)
+		}
+		e, n, u := ToENU(lat, 15.6, 0, lat2, lon2, 0)
+		if !near(e, -400, 0.1) || !near(n, 300, 0.1) || !near(u, 0, 0.1) {
+			t.Errorf("ENU at %v: expected (-400, 300, 0), got (%f, %f, %f)", lat, e, n, u)
+		}
+	}
+	// Crossing the pole turns north into south
+	lat, lon := Destination(89.99, 0, 0, 2000)
+	if !near(Distance(lat, lon, 90, 0), 2000-Distance(89.99, 0, 90, 0), 1e-6) || !near(math.Abs(lon), 180, 1e-9) {
+		t.Fatalf("expected to cross the pole onto the 180th meridian, got (%f, %f)", lat, lon)
+	}
+}
+
+func TestAntimeridian(t *testing.T) {
+	d := Distance(0, 179.9, 0, -179.9)
+	if want := 0.2 * EarthRadiusM * math.Pi / 180; !near(d, want, 1e-6) {
+		t.Fatalf("expected %.1f m across the antimeridian, got %.1f", want, d)
+	}
+	if b := Bearing(0, 179.9, 0, -179.9); !near(b, 90, 1e-9) {
+		t.Fatalf("expected to head east across the antimeridian, got %f", b)
+	}
+	lat, lon := Destination(-16.5, 179.99, 90, 5000)
+	if lon >= 0 || lon < -180 || !near(Distance(-16.5, 179.99, lat, lon), 5000, 1e-6) {
+		t.Fatalf("expected to land 5 km east on the far side, got (%f, %f)", lat, lon)
+	}
+	e, n, _ := ToENU(-16.5, 179.99, 0, lat, lon, 0)
+	if !near(e, 5000, 5) || !near(n, 0, 5) {
+		t.Fatalf("expected the point 5 km east in ENU, got (%f, %f)", e, n)
+	}
+	if got := NormalizeLon(540.5); !near(got, -179.5, 1e-9) {
+		t.Fatalf("expected 540.5 to normalize to -179.5, got %f", got)
+	}
+}
+
+func TestLocalFramesRoundTrip(t *testing.T) {
+	for _, ref := range [][2]float64{{48.2, 16.4}, {-33.9, 151.2}, {71.3, -156.8}, {0, 180}} {
+		lat, lon, alt := FromENU(ref[0], ref[1], 100, 1200, -800, 50)
+		e, n, u := ToENU(ref[0], ref[1], 100, lat, lon, alt)
+		if !near(e, 1200, 1e-6) || !near(n, -800, 1e-6) || !near(u, 50, 1e-6) {
+			t.Errorf("ENU round trip at %v: got (%f, %f, %f)", ref, e, n, u)
+		}
+		lat, lon, alt = FromNED(ref[0], ref[1], 100, -800, 1200, -50)
+		n, e, down := ToNED(ref[0], ref[1], 100, lat, lon, alt)
+		if !near(n, -800, 1e-6) || !near(e, 1200, 1e-6) || !near(down, -50, 1e-6) {
+			t.Errorf("NED round trip at %v: got (%f, %f, %f)", ref, n, e, down)
+		}
+		if lon < -180 || lon >= 180 {
+			t.Errorf("expected normalized longitude at %v, got %f", ref, lon)
+		}
+	}
+}
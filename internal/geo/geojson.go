@@ -0,0 +1,106 @@
+package geo
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// geoJSON holds the members of the GeoJSON objects ParseGeoJSON reads.
+type geoJSON struct {
+	Type        string          `json:"type"`
+	Coordinates json.RawMessage `json:"coordinates"`
+	Geometry    *geoJSON        `json:"geometry"`
+	Geometries  []geoJSON       `json:"geometries"`
+	Features    []geoJSON       `json:"features"`
+}
+
+// ParseGeoJSON reads the polygons of a GeoJSON Polygon, MultiPolygon,
+// GeometryCollection, Feature or FeatureCollection. Other geometries are
+// skipped; an object without any polygon is an error.
+func ParseGeoJSON(data []byte) (MultiPolygon, error) {
+	var obj geoJSON
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, fmt.Errorf("invalid GeoJSON: %w", err)
+	}
+	m, err := obj.polygons()
+	if err != nil {
+		return nil, err
+	}
+	if len(m) == 0 {
+		return nil, fmt.Errorf("GeoJSON %s contains no polygon", obj.Type)
+	}
+	return m, nil
+}
+
+func (g *geoJSON) polygons() (MultiPolygon, error) {
+	switch g.Type {
+	case "Polygon":
+		var coords [][][]float64
+		if err := json.Unmarshal(g.Coordinates, &coords); err != nil {
+			return nil, fmt.Errorf("invalid Polygon coordinates: %w", err)
+		}
+		p, err := polygon(coords)
+		if err != nil {
+			return nil, err
+		}
+		return MultiPolygon{p}, nil
+	case "MultiPolygon":
+		var coords [][][][]float64
+		if err := json.Unmarshal(g.Coordinates, &coords); err != nil {
+			return nil, fmt.Errorf("invalid MultiPolygon coordinates: %w", err)
+		}
+		var m MultiPolygon
+		for _, c := range coords {
+			p, err := polygon(c)
+			if err != nil {
+				return nil, err
+			}
+			m = append(m, p)
+		}
+		return m, nil
+	case "Feature":
+		if g.Geometry == nil {
+			return nil, nil
+		}
+		return g.Geometry.polygons()
+	case "GeometryCollection", "FeatureCollection":
+		var m MultiPolygon
+		for _, c := range append(g.Geometries, g.Features...) {
+			ps, err := c.polygons()
+			if err != nil {
+				return nil, err
+			}
+			m = append(m, ps...)
+		}
+		return m, nil
+	case "Point", "MultiPoint", "LineString", "MultiLineString":
+		return nil, nil
+	}
+	return nil, fmt.Errorf("unsupported GeoJSON type %q", g.Type)
+}
+
+// polygon converts GeoJSON rings of longitude, latitude positions, dropping
+// the closing position.
+func polygon(coords [][][]float64) (Polygon, error) {
+	if len(coords) == 0 {
+		return nil, fmt.Errorf("polygon without rings")
+	}
+	p := make(Polygon, len(coords))
+	for i, c := range coords {
+		var r Ring
+		for _, pos := range c {
+			if len(pos) < 2 {
+				return nil, fmt.Errorf("position with %d coordinates", len(pos))
+			}
+			r = append(r, Point{Lat: pos[1], Lon: pos[0]})
+		}
+		if n := len(r); n > 1 && r[0] == r[n-1] {
+			r = r[:n-1]
+		}
+		if len(r) < 3 {
+			return nil, fmt.Errorf("ring with %d distinct positions, need at least 3", len(r))
+		}
+		p[i] = r
+	}
+	return p, nil
+}
@@ -0,0 +1,34 @@
+package geo
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseGeoJSON(t *testing.T) {
+	m, err := ParseGeoJSON([]byte(`{"type": "Feature", "geometry": {"type": "Polygon", "coordinates": [
+		[[0, 0], [2, 0], [2, 2], [0, 2], [0, 0]],
+		[[0.5, 0.5], [1.5, 0.5], [1.5, 1.5], [0.5, 0.5]]
+	]}}`))
+	if err != nil {
+		t.Fatalf("ParseGeoJSON: %v", err)
+	}
+	if len(m) != 1 || len(m[0]) != 2 || len(m[0][0]) != 4 || m[0][0][1] != (Point{Lat: 0, Lon: 2}) {
+		t.Fatalf("expected one polygon with a hole, open rings and latitude second, got %v", m)
+	}
+	if !m.Contains(1.8, 0.2) || m.Contains(0.7, 1.2) {
+		t.Fatalf("expected the hole to be excluded")
+	}
+
+	for doc, want := range map[string]string{
+		`{"type": "Point", "coordinates": [0, 0]}`:               "no polygon",
+		`{"type": "Circle"}`:                                     "unsupported",
+		`{"type": "Polygon", "coordinates": [[[0, 0], [1, 1]]]}`: "at least 3",
+		`{"type": "MultiPolygon", "coordinates": [[[0, 0]]]}`:    "invalid MultiPolygon",
+		`not json`: "invalid GeoJSON",
+	} {
+		if _, err := ParseGeoJSON([]byte(doc)); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("%s: expected error containing %q, got %v", doc, want, err)
+		}
+	}
+}
@@ -0,0 +1,245 @@
+package geo
+
+import (
+	"math"
+	"sort"
+)
+
+// Point is a position on the earth's surface.
+type Point struct {
+	Lat float64
+	Lon float64
+}
+
+// Ring is a closed boundary; the last point joins back to the first.
+type Ring []Point
+
+// Polygon is an outer ring followed by the rings of its holes.
+type Polygon []Ring
+
+// MultiPolygon is an area made of one or more polygons.
+type MultiPolygon []Polygon
+
+// Contains reports whether lat, lon lies inside the ring. The ring's
+// longitudes are unwrapped edge by edge, so rings may span the antimeridian.
+func (r Ring) Contains(lat, lon float64) bool {
+	if len(r) < 3 {
+		return false
+	}
+	xs := make([]float64, len(r))
+	xs[0] = r[0].Lon
+	lo, hi := xs[0], xs[0]
+	for i := 1; i < len(r); i++ {
+		xs[i] = xs[i-1] + NormalizeLon(r[i].Lon-r[i-1].Lon)
+		lo, hi = math.Min(lo, xs[i]), math.Max(hi, xs[i])
+	}
+	mid := (lo + hi) / 2
+	x := mid + NormalizeLon(lon-mid)
+	in := false
+	for i, j := 0, len(r)-1; i < len(r); j, i = i, i+1 {
+		yi, yj := r[i].Lat, r[j].Lat
+		if (yi > lat) != (yj > lat) && x < xs[i]+(lat-yi)/(yj-yi)*(xs[j]-xs[i]) {
+			in = !in
+		}
+	}
+	return in
+}
+
+// Contains reports whether lat, lon lies inside the outer ring and outside
+// every hole.
+func (p Polygon) Contains(lat, lon float64) bool {
+	if len(p) == 0 || !p[0].Contains(lat, lon) {
+		return false
+	}
+	for _, hole := range p[1:] {
+		if hole.Contains(lat, lon) {
+			return false
+		}
+	}
+	return true
+}
+
+// Contains reports whether lat, lon lies inside any of the polygons.
+func (m MultiPolygon) Contains(lat, lon float64) bool {
+	for _, p := range m {
+		if p.Contains(lat, lon) {
+			return true
+		}
+	}
+	return false
+}
+
+// DistanceToBoundary returns the distance in meters from lat, lon to the
+// nearest edge of any ring, holes included. Edges are measured in the local
+// tangent plane at lat, lon, accurate for the few kilometers of a zone.
+func (m MultiPolygon) DistanceToBoundary(lat, lon float64) float64 {
+	best := math.Inf(1)
+	for _, p := range m {
+		for _, r := range p {
+			for i, j := 0, len(r)-1; i < len(r); j, i = i, i+1 {
+				ax, ay, _ := ToENU(lat, lon, 0, r[j].Lat, r[j].Lon, 0)
+				bx, by, _ := ToENU(lat, lon, 0, r[i].Lat, r[i].Lon, 0)
+				best = math.Min(best, segmentDistance(ax, ay, bx, by))
+			}
+		}
+	}
+	return best
+}
+
+// segmentDistance returns the distance from the origin to the segment a-b.
+func segmentDistance(ax, ay, bx, by float64) float64 {
+	dx, dy := bx-ax, by-ay
+	t := 0.0
+	if l := dx*dx + dy*dy; l > 0 {
+		t = math.Max(0, math.Min(1, -(ax*dx+ay*dy)/l))
+	}
+	return math.Hypot(ax+t*dx, ay+t*dy)
+}
+
+// BoundingCircle returns the center of the bounding box of the outer rings
+// and the distance from it to the farthest vertex.
+func (m MultiPolygon) BoundingCircle() (lat, lon, radiusM float64) {
+	var ref float64
+	minLat, maxLat := math.Inf(1), math.Inf(-1)
+	minLon, maxLon := math.Inf(1), math.Inf(-1)
+	first := true
+	for _, p := range m {
+		if len(p) == 0 {
+			continue
+		}
+		for _, pt := range p[0] {
+			if first {
+				ref, first = pt.Lon, false
+			}
+			x := ref + NormalizeLon(pt.Lon-ref) // Unwrapped across the antimeridian
+			minLat, maxLat = math.Min(minLat, pt.Lat), math.Max(maxLat, pt.Lat)
+			minLon, maxLon = math.Min(minLon, x), math.Max(maxLon, x)
+		}
+	}
+	if first {
+		return 0, 0, 0
+	}
+	lat, lon = (minLat+maxLat)/2, NormalizeLon((minLon+maxLon)/2)
+	return lat, lon, m.Reach(lat, lon)
+}
+
+// Reach returns the distance in meters from lat, lon to the farthest vertex
+// of the outer rings.
+func (m MultiPolygon) Reach(lat, lon float64) float64 {
+	var reach float64
+	for _, p := range m {
+		if len(p) == 0 {
+			continue
+		}
+		for _, pt := range p[0] {
+			reach = math.Max(reach, Distance(lat, lon, pt.Lat, pt.Lon))
+		}
+	}
+	return reach
+}
+
+// InteriorPoint returns a point inside the polygons, for shapes such as
+// rings around a hole or L-shaped zones whose bounding box center lies
+// outside them: the middle of the widest stretch of a polygon along its
+// middle latitude, or along a quarter of its height if that misses it.
+func (m MultiPolygon) InteriorPoint() (lat, lon float64) {
+	best := -1.0
+	for _, p := range m {
+		if len(p) == 0 || len(p[0]) < 3 {
+			continue
+		}
+		ref := p[0][0].Lon
+		minLat, maxLat := math.Inf(1), math.Inf(-1)
+		minLon, maxLon := math.Inf(1), math.Inf(-1)
+		for _, pt := range p[0] {
+			x := ref + NormalizeLon(pt.Lon-ref)
+			minLat, maxLat = math.Min(minLat, pt.Lat), math.Max(maxLat, pt.Lat)
+			minLon, maxLon = math.Min(minLon, x), math.Max(maxLon, x)
+		}
+		for _, f := range []float64{0.5, 0.25, 0.75} {
+			y := minLat + f*(maxLat-minLat)
+			parts := MultiPolygon{p}.Clip(Point{Lat: y, Lon: minLon}, Point{Lat: y, Lon: maxLon})
+			for _, part := range parts {
+				if d := Distance(part[0].Lat, part[0].Lon, part[1].Lat, part[1].Lon); d > best {
+					best = d
+					lat, lon = y, NormalizeLon(part[0].Lon+NormalizeLon(part[1].Lon-part[0].Lon)/2)
+				}
+			}
+			if len(parts) > 0 {
+				break
+			}
+		}
+	}
+	if best < 0 {
+		lat, lon, _ = m.BoundingCircle()
+	}
+	return lat, lon
+}
+
+// Clip returns the stretches of the straight line from a to b that lie
+// inside the polygons, in order from a. Like Contains it works in plain
+// latitude and longitude, unwrapped across the antimeridian.
+func (m MultiPolygon) Clip(a, b Point) [][2]Point {
+	bx, by := NormalizeLon(b.Lon-a.Lon), b.Lat-a.Lat
+	ts := []float64{0, 1}
+	for _, p := range m {
+		for _, r := range p {
+			for i, j := 0, len(r)-1; i < len(r); j, i = i, i+1 {
+				cx, cy := NormalizeLon(r[j].Lon-a.Lon), r[j].Lat-a.Lat
+				dx, dy := NormalizeLon(r[i].Lon-r[j].Lon), r[i].Lat-r[j].Lat
+				den := bx*dy - by*dx
+				if den == 0 {
+					continue // Parallel edges cross nowhere or along their length
+				}
+				t := (cx*dy - cy*dx) / den
+				u := (cx*by - cy*bx) / den
+				if t > 0 && t < 1 && u >= 0 && u <= 1 {
+					ts = append(ts, t)
+				}
+			}
+		}
+	}
+	sort.Float64s(ts)
+	at := func(t float64) Point {
+		return Point{Lat: a.Lat + t*by, Lon: NormalizeLon(a.Lon + t*bx)}
+	}
+	var parts [][2]Point
+	end := -1.0
+	for k := 1; k < len(ts); k++ {
+		t0, t1 := ts[k-1], ts[k]
+		if t1-t0 < 1e-12 {
+			continue
+		}
+		mid := at((t0 + t1) / 2)
+		if !m.Contains(mid.Lat, mid.Lon) {
+			continue
+		}
+		if t0 == end {
+			parts[len(parts)-1][1] = at(t1) // Vertices on the line split it without leaving the shape
+		} else {
+			parts = append(parts, [2]Point{at(t0), at(t1)})
+		}
+		end = t1
+	}
+	return parts
+}
+
+// Coordinates returns the polygons as GeoJSON MultiPolygon coordinates,
+// longitude first, with every ring closed.
+func (m MultiPolygon) Coordinates() [][][][2]float64 {
+	out := make([][][][2]float64, len(m))
+	for i, p := range m {
+		out[i] = make([][][2]float64, len(p))
+		for j, r := range p {
+			ring := make([][2]float64, 0, len(r)+1)
+			for _, pt := range r {
+				ring = append(ring, [2]float64{pt.Lon, pt.Lat})
+			}
+			if len(r) > 0 {
+				ring = append(ring, [2]float64{r[0].Lon, r[0].Lat})
+			}
+			out[i][j] = ring
+		}
+	}
+	return out
+}
@@ -0,0 +1,100 @@
+package geo
+
+import (
+	"math"
+	"testing"
+)
+
+func square(lat, lon, half float64) Ring {
+	return Ring{{lat - half, lon - half}, {lat - half, lon + half}, {lat + half, lon + half}, {lat + half, lon - half}}
+}
+
+func TestPolygonContainsWithHoles(t *testing.T) {
+	m := MultiPolygon{
+		{square(48, 16, 1), square(48, 16, 0.25)},
+		{square(52, 21, 0.5)},
+	}
+	for _, tc := range []struct {
+		lat, lon float64
+		in       bool
+	}{
+		{48.5, 16.5, true},  // Inside the first polygon
+		{48, 16, false},     // Inside its hole
+		{52.1, 21.2, true},  // Inside the second polygon
+		{50, 18, false},     // Between them
+		{47.5, 17.5, false}, // East of the first
+	} {
+		if got := m.Contains(tc.lat, tc.lon); got != tc.in {
+			t.Errorf("(%v, %v): expected inside=%t", tc.lat, tc.lon, tc.in)
+		}
+	}
+}
+
+func TestPolygonAcrossAntimeridianAndPole(t *testing.T) {
+	fiji := MultiPolygon{{Ring{{-17, 179}, {-17, -179}, {-15, -179}, {-15, 179}}}}
+	if !fiji.Contains(-16, 179.9) || !fiji.Contains(-16, -179.5) || fiji.Contains(-16, 0) || fiji.Contains(-16, 178) {
+		t.Fatalf("expected membership across the antimeridian")
+	}
+	lat, lon, r := fiji.BoundingCircle()
+	if lat != -16 || lon != -180 || r > 160000 {
+		t.Fatalf("expected the bounding circle on the antimeridian, got (%v, %v) %v m", lat, lon, r)
+	}
+	svalbard := MultiPolygon{{Ring{{78, 10}, {78, 30}, {80.5, 30}, {80.5, 10}}}}
+	if !svalbard.Contains(79, 20) || svalbard.Contains(81, 20) || svalbard.Contains(79, 31) {
+		t.Fatalf("expected membership at high latitude")
+	}
+}
+
+func TestCoordinatesCloseRings(t *testing.T) {
+	c := MultiPolygon{{square(0, 0, 1)}}.Coordinates()
+	ring := c[0][0]
+	if len(ring) != 5 || ring[0] != ring[4] || ring[0] != [2]float64{-1, -1} {
+		t.Fatalf("expected a closed ring of longitude, latitude pairs, got %v", ring)
+	}
+}
+
+func TestDistanceToBoundary(t *testing.T) {
+	m := MultiPolygon{{square(0, 0, 1), square(0, 0, 0.5)}}
+	edge := Distance(0, 0.75, 0, 1)
+	if d := m.DistanceToBoundary(0, 0.75); math.Abs(d-edge) > edge*0.01 {
+		t.Fatalf("expected %v m to the outer ring, got %v", edge, d)
+	}
+	hole := Distance(0, 0, 0, 0.5)
+	if d := m.DistanceToBoundary(0, 0); math.Abs(d-hole) > hole*0.01 {
+		t.Fatalf("expected %v m to the hole, got %v", hole, d)
+	}
+	if d := m.DistanceToBoundary(0, 1); d > 1e-6 {
+		t.Fatalf("expected zero on the edge, got %v", d)
+	}
+}
+
+// u is a U-shaped polygon open to the north, its bounding box center in the notch.
+var u = MultiPolygon{{Ring{{0, 0}, {0, 3}, {3, 3}, {3, 2}, {1, 2}, {1, 1}, {3, 1}, {3, 0}}}}
+
+func TestInteriorPointOfConcavePolygon(t *testing.T) {
+	if lat, lon, _ := u.BoundingCircle(); u.Contains(lat, lon) {
+		t.Fatalf("expected the bounding box center outside the U")
+	}
+	lat, lon := u.InteriorPoint()
+	if !u.Contains(lat, lon) || u.DistanceToBoundary(lat, lon) < Distance(0, 0, 0, 0.4) {
+		t.Fatalf("expected a point well inside the U, got (%v, %v)", lat, lon)
+	}
+}
+
+func TestClip(t *testing.T) {
+	parts := u.Clip(Point{Lat: 2, Lon: -1}, Point{Lat: 2, Lon: 4})
+	if len(parts) != 2 {
+		t.Fatalf("expected the line to cross both arms of the U, got %v", parts)
+	}
+	for i, want := range [][2]float64{{0, 1}, {2, 3}} {
+		if math.Abs(parts[i][0].Lon-want[0]) > 1e-9 || math.Abs(parts[i][1].Lon-want[1]) > 1e-9 {
+			t.Errorf("part %d: expected lon %v to %v, got %v", i, want[0], want[1], parts[i])
+		}
+	}
+	if parts := u.Clip(Point{Lat: 0.5, Lon: 0.5}, Point{Lat: 0.5, Lon: 2.5}); len(parts) != 1 {
+		t.Fatalf("expected a line along the base of the U inside it, got %v", parts)
+	}
+	if parts := u.Clip(Point{Lat: 2, Lon: 1.2}, Point{Lat: 2, Lon: 1.8}); len(parts) != 0 {
+		t.Fatalf("expected nothing of a line in the notch, got %v", parts)
+	}
+}
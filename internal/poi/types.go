@@ -0,0 +1,61 @@
+// Package poi models neutral points of interest such as survivors, wreckage
+// or supply caches that drones search for.
+package poi
+
+import (
+	"time"
+
+	"droneops-sim/internal/telemetry"
+)
+
+// Type identifies the kind of point of interest.
+type Type string
+
+const (
+	Survivor Type = "survivor"
+	Wreckage Type = "wreckage"
+	Cache    Type = "cache"
+)
+
+// Status tracks a point of interest through the search.
+type Status string
+
+const (
+	// StatusMissing indicates the point of interest has not been found yet.
+	StatusMissing Status = "missing"
+	// StatusFound indicates a drone has located the point of interest.
+	StatusFound Status = "found"
+	// StatusExtracted indicates the point of interest has been recovered.
+	StatusExtracted Status = "extracted"
+)
+
+// POI represents one point of interest. Difficulty in [0,1] reduces the
+// detection confidence, e.g. for a survivor hidden under tree cover.
+// CarrierID names the drone bringing a found point back to its base.
+type POI struct {
+	ID         string
+	Type       Type
+	Position   telemetry.Position
+	Difficulty float64
+	Status     Status
+	CarrierID  string
+}
+
+// DetectionRow describes a drone observing a point of interest.
+type DetectionRow struct {
+	ClusterID  string    `json:"cluster_id"`
+	DroneID    string    `json:"drone_id"`
+	POIID      string    `json:"poi_id"`
+	POIType    Type      `json:"poi_type"`
+	Status     Status    `json:"status"`
+	Lat        float64   `json:"lat"`
+	Lon        float64   `json:"lon"`
+	Alt        float64   `json:"alt"`
+	DroneLat   float64   `json:"drone_lat"`
+	DroneLon   float64   `json:"drone_lon"`
+	DroneAlt   float64   `json:"drone_alt"`
+	DistanceM  float64   `json:"distance_m"`
+	BearingDeg float64   `json:"bearing_deg"`
+	Confidence float64   `json:"confidence"`
+	Timestamp  time.Time `json:"ts"`
+}
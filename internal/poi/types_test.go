@@ -0,0 +1,36 @@
+package poi
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestDetectionRowJSON(t *testing.T) {
+	d := DetectionRow{
+		ClusterID:  "c",
+		DroneID:    "d",
+		POIID:      "p",
+		POIType:    Survivor,
+		Status:     StatusFound,
+		DistanceM:  7,
+		Confidence: 10,
+		Timestamp:  time.Unix(0, 0).UTC(),
+	}
+	data, err := json.Marshal(d)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	for _, key := range []string{"poi_id", "poi_type", "status", "drone_lat", "distance_m", "bearing_deg", "confidence"} {
+		if _, ok := m[key]; !ok {
+			t.Fatalf("missing %s in json: %s", key, string(data))
+		}
+	}
+	if m["poi_type"] != "survivor" || m["status"] != "found" {
+		t.Fatalf("unexpected json: %s", string(data))
+	}
+}
@@ -10,24 +10,39 @@ func BuiltIn() map[string]Scenario {
 				{
 					Name:        "setup",
 					Description: "Convoy forms up and prepares to depart.",
-					Triggers:    []Trigger{{Event: "time_elapsed", Value: 30, Next: "escalation"}},
+					Triggers: []Trigger{
+						{Event: "time_elapsed", Value: 30, Next: "escalation"},
+						{Event: "convoy_arrived", Value: 1, Next: "resolution"},
+						{Event: "convoy_destroyed", Value: 1, Next: "failure"},
+					},
 				},
 				{
 					Name:            "escalation",
 					Description:     "Light enemy forces probe the escort.",
 					EnemyObjectives: []EnemyObjective{{ID: "bandits", Action: "harass", Target: "convoy"}},
-					Triggers:        []Trigger{{Event: "enemy_destroyed", Value: 3, Next: "climax"}},
+					Triggers: []Trigger{
+						{Event: "enemy_destroyed", Value: 3, Next: "climax"},
+						{Event: "convoy_arrived", Value: 1, Next: "resolution"},
+						{Event: "convoy_destroyed", Value: 1, Next: "failure"},
+					},
 				},
 				{
 					Name:            "climax",
 					Description:     "A coordinated ambush hits the convoy near a choke point.",
 					EnemyObjectives: []EnemyObjective{{ID: "ambush", Action: "attack", Target: "convoy"}},
-					Triggers:        []Trigger{{Event: "enemy_destroyed", Value: 5, Next: "resolution"}},
+					Triggers: []Trigger{
+						{Event: "convoy_arrived", Value: 1, Next: "resolution"},
+						{Event: "convoy_destroyed", Value: 1, Next: "failure"},
+					},
 				},
 				{
 					Name:        "resolution",
 					Description: "Remaining threats fall back as the convoy reaches safety.",
 				},
+				{
+					Name:        "failure",
+					Description: "The convoy is lost before reaching the forward operating base.",
+				},
 			},
 		},
 		"search-and-rescue": {
@@ -63,24 +78,51 @@ func BuiltIn() map[string]Scenario {
 				{
 					Name:        "setup",
 					Description: "Defenders fortify the station and establish fields of fire.",
-					Triggers:    []Trigger{{Event: "time_elapsed", Value: 30, Next: "escalation"}},
+					Triggers: []Trigger{
+						{Event: "time_elapsed", Value: 30, Next: "escalation"},
+						{Event: "asset_destroyed", Value: 1, Next: "failure"},
+					},
 				},
 				{
 					Name:            "escalation",
 					Description:     "The first wave tests the defenses.",
 					EnemyObjectives: []EnemyObjective{{ID: "wave1", Action: "attack", Target: "station"}},
-					Triggers:        []Trigger{{Event: "enemy_destroyed", Value: 5, Next: "climax"}},
+					Spawn: Spawn{Enemies: []EnemySpawn{
+						{Group: "wave1", Type: "drone", Count: 5, Bearing: 90, DistanceKM: 5},
+					}},
+					Triggers: []Trigger{
+						{Event: "enemy_destroyed", Value: 5, Next: "climax"},
+						{Event: "asset_destroyed", Value: 1, Next: "failure"},
+					},
 				},
 				{
 					Name:            "climax",
 					Description:     "A massive assault threatens to overwhelm the defenders.",
 					EnemyObjectives: []EnemyObjective{{ID: "wave2", Action: "attack", Target: "station"}},
-					Triggers:        []Trigger{{Event: "time_elapsed", Value: 120, Next: "resolution"}},
+					Spawn: Spawn{
+						Enemies: []EnemySpawn{
+							{Group: "wave2", Type: "drone", Count: 10, Bearing: 270, DistanceKM: 8},
+							{Group: "wave2", Type: "vehicle", Count: 3, Bearing: 200, DistanceKM: 6},
+						},
+						Fleets: []FleetSpawn{{Name: "reserve", Model: "small-fpv", Count: 5, Pattern: "loiter"}},
+					},
+					Triggers: []Trigger{
+						{All: []Condition{
+							{Metric: "time_elapsed", Op: ">=", Value: 30},
+							{Metric: "enemy_count", Op: "==", Value: 0},
+						}, Next: "resolution"},
+						{Event: "time_elapsed", Value: 120, Next: "resolution"},
+						{Event: "asset_destroyed", Value: 1, Next: "failure"},
+					},
 				},
 				{
 					Name:        "resolution",
 					Description: "Enemy forces withdraw and the station remains secure.",
 				},
+				{
+					Name:        "failure",
+					Description: "The station falls before the assault is broken.",
+				},
 			},
 		},
 	}
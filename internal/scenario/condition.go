@@ -0,0 +1,116 @@
+package scenario
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Metrics is a snapshot of simulation state that trigger conditions are
+// evaluated against. Missing metrics read as zero.
+type Metrics map[string]float64
+
+// Metric names provided by the simulator in addition to the phase-relative
+// event counters, which are exposed under their event type.
+const (
+	// MetricActiveDrones counts drones that have not failed.
+	MetricActiveDrones = "active_drones"
+	// MetricTotalDrones counts all drones across fleets.
+	MetricTotalDrones = "total_drones"
+	// MetricAvgBattery is the mean battery level of all drones in percent.
+	MetricAvgBattery = "avg_battery"
+	// MetricEnemyCount counts active enemies.
+	MetricEnemyCount = "enemy_count"
+	// MetricFollowers counts drones currently assigned to follow an enemy.
+	MetricFollowers = "followers"
+	// MetricDronesPrefix prefixes per-status drone counts, e.g. drones_low_battery.
+	MetricDronesPrefix = "drones_"
+	// MetricEnemiesPrefix prefixes per-type active enemy counts, e.g. enemies_vehicle.
+	MetricEnemiesPrefix = "enemies_"
+	// MetricHealthSuffix follows a fixed asset's ID for its remaining hit
+	// points in percent, e.g. station_health.
+	MetricHealthSuffix = "_health"
+)
+
+// Comparison operators accepted in conditions.
+var knownOps = []string{">=", ">", "<=", "<", "==", "!="}
+
+// Condition is a comparison of a metric against a value, or a nested group of
+// conditions that must all (All) or at least one (Any) hold.
+type Condition struct {
+	Metric string      `yaml:"metric,omitempty"`
+	Op     string      `yaml:"op,omitempty"`
+	Value  float64     `yaml:"value,omitempty"`
+	All    []Condition `yaml:"all,omitempty"`
+	Any    []Condition `yaml:"any,omitempty"`
+}
+
+// Eval reports whether the condition holds for the given metrics.
+func (c Condition) Eval(m Metrics) bool {
+	if c.Metric == "" {
+		return evalGroups(c.All, c.Any, m)
+	}
+	v := m[c.Metric]
+	switch c.Op {
+	case ">=":
+		return v >= c.Value
+	case ">":
+		return v > c.Value
+	case "<=":
+		return v <= c.Value
+	case "<":
+		return v < c.Value
+	case "==":
+		return v == c.Value
+	case "!=":
+		return v != c.Value
+	}
+	return false
+}
+
+// String renders the condition as an expression, e.g. "(avg_battery < 30 || enemy_count == 0)".
+func (c Condition) String() string {
+	if c.Metric == "" {
+		return formatGroups(c.All, c.Any)
+	}
+	return fmt.Sprintf("%s %s %g", c.Metric, c.Op, c.Value)
+}
+
+// evalGroups requires every condition in all and, if any is set, at least one
+// condition in any. Two empty groups never hold.
+func evalGroups(all, any []Condition, m Metrics) bool {
+	if len(all) == 0 && len(any) == 0 {
+		return false
+	}
+	for _, c := range all {
+		if !c.Eval(m) {
+			return false
+		}
+	}
+	if len(any) == 0 {
+		return true
+	}
+	for _, c := range any {
+		if c.Eval(m) {
+			return true
+		}
+	}
+	return false
+}
+
+func formatGroups(all, any []Condition) string {
+	var parts []string
+	for _, c := range all {
+		parts = append(parts, c.String())
+	}
+	if len(any) > 0 {
+		alts := make([]string, len(any))
+		for i, c := range any {
+			alts[i] = c.String()
+		}
+		parts = append(parts, "("+strings.Join(alts, " || ")+")")
+	}
+	if len(parts) == 1 {
+		return parts[0]
+	}
+	return "(" + strings.Join(parts, " && ") + ")"
+}
@@ -0,0 +1,51 @@
+package scenario
+
+import "testing"
+
+func TestConditionEval(t *testing.T) {
+	m := Metrics{"avg_battery": 25, "enemy_count": 2, "active_drones": 8}
+	cases := []struct {
+		cond Condition
+		want bool
+	}{
+		{Condition{Metric: "avg_battery", Op: "<", Value: 30}, true},
+		{Condition{Metric: "enemy_count", Op: "==", Value: 0}, false},
+		{Condition{Metric: "missing", Op: "==", Value: 0}, true},
+		{Condition{Metric: "active_drones", Op: "~", Value: 8}, false},
+		{Condition{Any: []Condition{
+			{Metric: "enemy_count", Op: "==", Value: 0},
+			{Metric: "avg_battery", Op: "<=", Value: 25},
+		}}, true},
+		{Condition{All: []Condition{
+			{Metric: "active_drones", Op: ">", Value: 5},
+			{Any: []Condition{{Metric: "enemy_count", Op: "!=", Value: 2}}},
+		}}, false},
+		{Condition{}, false},
+	}
+	for i, c := range cases {
+		if got := c.cond.Eval(m); got != c.want {
+			t.Fatalf("case %d (%s): expected %v, got %v", i, c.cond, c.want, got)
+		}
+	}
+}
+
+func TestTriggerEvalAndString(t *testing.T) {
+	tr := Trigger{
+		Event: "time_elapsed", Value: 60,
+		All:  []Condition{{Metric: "active_drones", Op: "<", Value: 10}},
+		Any:  []Condition{{Metric: "avg_battery", Op: "<", Value: 30}, {Metric: "enemy_count", Op: "==", Value: 0}},
+		Next: "end",
+	}
+	if want := "time_elapsed >= 60 && active_drones < 10 && (avg_battery < 30 || enemy_count == 0)"; tr.String() != want {
+		t.Fatalf("expected %q, got %q", want, tr.String())
+	}
+	if tr.Eval(Metrics{"time_elapsed": 59, "active_drones": 5, "enemy_count": 0}) {
+		t.Fatalf("expected event threshold to gate the trigger")
+	}
+	if !tr.Eval(Metrics{"time_elapsed": 60, "active_drones": 5, "avg_battery": 80}) {
+		t.Fatalf("expected trigger to fire")
+	}
+	if tr.Matches(Event{Type: "time_elapsed", Value: 60}) {
+		t.Fatalf("compound trigger must not match a single event")
+	}
+}
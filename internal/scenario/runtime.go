@@ -0,0 +1,107 @@
+package scenario
+
+import "time"
+
+// Event types understood by the runtime.
+const (
+	// EventTimeElapsed measures seconds spent in the current phase.
+	EventTimeElapsed = "time_elapsed"
+	// EventEnemyDestroyed counts enemies neutralized during the current phase.
+	EventEnemyDestroyed = "enemy_destroyed"
+	// EventSurvivorFound counts survivors located during the current phase.
+	EventSurvivorFound = "survivor_found"
+	// EventSurvivorExtracted counts survivors brought to safety during the current phase.
+	EventSurvivorExtracted = "survivor_extracted"
+	// EventConvoyArrived counts convoys that reached their destination during the current phase.
+	EventConvoyArrived = "convoy_arrived"
+	// EventConvoyDestroyed counts convoys lost during the current phase.
+	EventConvoyDestroyed = "convoy_destroyed"
+	// EventAssetDestroyed counts fixed assets lost during the current phase.
+	EventAssetDestroyed = "asset_destroyed"
+)
+
+// Transition describes a phase change performed by a Runtime. Event is the
+// trigger's event type or, for triggers with conditions, the rendered condition.
+type Transition struct {
+	From  string
+	To    string
+	Event string
+	Value int
+	At    time.Time
+}
+
+// Runtime tracks the active phase of a scenario and advances it as events arrive.
+// Event counters and the time_elapsed clock are relative to the current phase and
+// reset on every transition.
+type Runtime struct {
+	scenario *Scenario
+	phase    string
+	entered  time.Time
+	counts   map[string]int
+}
+
+// NewRuntime starts a scenario in its first phase at the given time.
+func NewRuntime(s *Scenario, start time.Time) *Runtime {
+	r := &Runtime{scenario: s, entered: start, counts: make(map[string]int)}
+	if len(s.Phases) > 0 {
+		r.phase = s.Phases[0].Name
+	}
+	return r
+}
+
+// Scenario returns the scenario driven by the runtime.
+func (r *Runtime) Scenario() *Scenario { return r.scenario }
+
+// Phase returns the name of the active phase.
+func (r *Runtime) Phase() string { return r.phase }
+
+// Entered returns when the active phase was entered.
+func (r *Runtime) Entered() time.Time { return r.entered }
+
+// CurrentPhase returns the definition of the active phase.
+func (r *Runtime) CurrentPhase() (Phase, bool) {
+	return r.scenario.Phase(r.phase)
+}
+
+// Record accumulates an event occurrence for the active phase.
+func (r *Runtime) Record(ev Event) {
+	r.counts[ev.Type] += ev.Value
+}
+
+// Count returns the accumulated value for an event type in the active phase.
+func (r *Runtime) Count(eventType string) int {
+	return r.counts[eventType]
+}
+
+// Advance evaluates the triggers of the active phase against the given
+// simulation metrics and moves to the next phase when one fires. The event
+// counters and time_elapsed of the active phase are added to the metrics.
+// At most one transition happens per call.
+func (r *Runtime) Advance(now time.Time, metrics Metrics) (Transition, bool) {
+	p, ok := r.CurrentPhase()
+	if !ok {
+		return Transition{}, false
+	}
+	m := make(Metrics, len(metrics)+len(r.counts)+1)
+	for k, v := range metrics {
+		m[k] = v
+	}
+	for k, v := range r.counts {
+		m[k] = float64(v)
+	}
+	m[EventTimeElapsed] = float64(int(now.Sub(r.entered).Seconds()))
+	for _, tr := range p.Triggers {
+		if !tr.Eval(m) {
+			continue
+		}
+		t := Transition{From: r.phase, To: tr.Next, Event: tr.Event, Value: int(m[tr.Event]), At: now}
+		if tr.Compound() {
+			t.Event, t.Value = tr.String(), 0
+		}
+		r.phase = tr.Next
+		r.entered = now
+		r.counts = make(map[string]int)
+		return t, true
+	}
+	return Transition{}, false
+}
@@ -0,0 +1,77 @@
+package scenario
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRuntimeAdvancesOnTimeElapsed(t *testing.T) {
+	s := &Scenario{Phases: []Phase{
+		{Name: "setup", Triggers: []Trigger{{Event: "time_elapsed", Value: 10, Next: "climax"}}},
+		{Name: "climax"},
+	}}
+	start := time.Unix(0, 0)
+	rt := NewRuntime(s, start)
+	if rt.Phase() != "setup" {
+		t.Fatalf("expected first phase, got %s", rt.Phase())
+	}
+	if _, ok := rt.Advance(start.Add(9*time.Second), nil); ok {
+		t.Fatalf("unexpected transition before threshold")
+	}
+	tr, ok := rt.Advance(start.Add(10*time.Second), nil)
+	if !ok || tr.From != "setup" || tr.To != "climax" || tr.Event != "time_elapsed" || tr.Value != 10 {
+		t.Fatalf("unexpected transition %+v", tr)
+	}
+	if !rt.Entered().Equal(start.Add(10 * time.Second)) {
+		t.Fatalf("expected phase entry time to be updated")
+	}
+	if _, ok := rt.Advance(start.Add(time.Hour), nil); ok {
+		t.Fatalf("final phase should not transition")
+	}
+}
+
+func TestRuntimeCountersResetPerPhase(t *testing.T) {
+	s := &Scenario{Phases: []Phase{
+		{Name: "a", Triggers: []Trigger{{Event: "enemy_destroyed", Value: 2, Next: "b"}}},
+		{Name: "b", Triggers: []Trigger{{Event: "enemy_destroyed", Value: 2, Next: "c"}}},
+		{Name: "c"},
+	}}
+	now := time.Unix(0, 0)
+	rt := NewRuntime(s, now)
+	rt.Record(Event{Type: "enemy_destroyed", Value: 3})
+	if tr, ok := rt.Advance(now, nil); !ok || tr.To != "b" {
+		t.Fatalf("expected transition to b, got %+v", tr)
+	}
+	if rt.Count("enemy_destroyed") != 0 {
+		t.Fatalf("expected counters to reset on transition")
+	}
+	rt.Record(Event{Type: "enemy_destroyed", Value: 1})
+	if _, ok := rt.Advance(now, nil); ok {
+		t.Fatalf("unexpected transition with carried over count")
+	}
+}
+
+func TestRuntimeCompoundTrigger(t *testing.T) {
+	s := &Scenario{Phases: []Phase{
+		{Name: "hold", Triggers: []Trigger{{
+			All: []Condition{
+				{Metric: "time_elapsed", Op: ">=", Value: 60},
+				{Metric: "active_drones", Op: "<", Value: 10},
+			},
+			Next: "fallback",
+		}}},
+		{Name: "fallback"},
+	}}
+	start := time.Unix(0, 0)
+	rt := NewRuntime(s, start)
+	if _, ok := rt.Advance(start.Add(time.Minute), Metrics{"active_drones": 12}); ok {
+		t.Fatalf("unexpected transition with enough drones")
+	}
+	if _, ok := rt.Advance(start.Add(30*time.Second), Metrics{"active_drones": 4}); ok {
+		t.Fatalf("unexpected transition before time condition")
+	}
+	tr, ok := rt.Advance(start.Add(time.Minute), Metrics{"active_drones": 4})
+	if !ok || tr.To != "fallback" || tr.Event != "time_elapsed >= 60 && active_drones < 10" {
+		t.Fatalf("unexpected transition %+v", tr)
+	}
+}
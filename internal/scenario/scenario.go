@@ -3,6 +3,8 @@ package scenario
 import (
 	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
 
 	"gopkg.in/yaml.v3"
 )
@@ -19,21 +21,71 @@ type Phase struct {
 	Name            string           `yaml:"name"`
 	Description     string           `yaml:"description,omitempty"`
 	EnemyObjectives []EnemyObjective `yaml:"enemy_objectives,omitempty"`
+	Spawn           Spawn            `yaml:"spawn,omitempty"`
 	Triggers        []Trigger        `yaml:"triggers,omitempty"`
 }
 
 // EnemyObjective declares a dynamic behaviour for an enemy entity during a phase.
+// ID matches an enemy ID, an enemy group or "*" for all enemies.
 type EnemyObjective struct {
-	ID     string `yaml:"id"`
-	Action string `yaml:"action"`
-	Target string `yaml:"target,omitempty"`
+	ID     string  `yaml:"id"`
+	Action string  `yaml:"action"`
+	Target string  `yaml:"target,omitempty"`
+	Route  []Point `yaml:"route,omitempty"`
 }
 
-// Trigger moves the scenario to another phase based on an event.
+// Spawn lists the enemies and friendly fleets added when a phase becomes active.
+type Spawn struct {
+	Enemies []EnemySpawn `yaml:"enemies,omitempty"`
+	Fleets  []FleetSpawn `yaml:"fleets,omitempty"`
+}
+
+// EnemySpawn adds Count enemies of a type to a group. They appear around
+// Point or, when unset, the centre of the zone or mission named by Region,
+// optionally offset by DistanceKM along Bearing (degrees from north).
+type EnemySpawn struct {
+	Group      string          `yaml:"group,omitempty"`
+	Type       string          `yaml:"type"`
+	Count      int             `yaml:"count"`
+	Region     string          `yaml:"region,omitempty"`
+	Point      *Point          `yaml:"point,omitempty"`
+	Bearing    float64         `yaml:"bearing,omitempty"`
+	DistanceKM float64         `yaml:"distance_km,omitempty"`
+	Objective  *SpawnObjective `yaml:"objective,omitempty"`
+}
+
+// SpawnObjective is the objective given to a spawned group for the rest of the phase.
+type SpawnObjective struct {
+	Action string  `yaml:"action"`
+	Target string  `yaml:"target,omitempty"`
+	Route  []Point `yaml:"route,omitempty"`
+}
+
+// FleetSpawn launches Count friendly drones of a model. Name defaults to the
+// model, Pattern to patrol and Region, a zone name, to the first zone.
+type FleetSpawn struct {
+	Name    string `yaml:"name,omitempty"`
+	Model   string `yaml:"model"`
+	Count   int    `yaml:"count"`
+	Pattern string `yaml:"pattern,omitempty"`
+	Mission string `yaml:"mission,omitempty"`
+	Region  string `yaml:"region,omitempty"`
+}
+
+// Point is a geographic position used by patrol routes and spawns.
+type Point struct {
+	Lat float64 `yaml:"lat"`
+	Lon float64 `yaml:"lon"`
+}
+
+// Trigger moves the scenario to another phase based on an event threshold,
+// metric conditions or both. All set parts must hold for the trigger to fire.
 type Trigger struct {
-	Event string `yaml:"event"`
-	Value int    `yaml:"value"`
-	Next  string `yaml:"next"`
+	Event string      `yaml:"event,omitempty"`
+	Value int         `yaml:"value,omitempty"`
+	All   []Condition `yaml:"all,omitempty"`
+	Any   []Condition `yaml:"any,omitempty"`
+	Next  string      `yaml:"next"`
 }
 
 // Event represents a runtime occurrence that may advance the scenario.
@@ -63,10 +115,78 @@ func (s *Scenario) NextPhase(current string, ev Event) (next string, ok bool) {
 			continue
 		}
 		for _, tr := range p.Triggers {
-			if tr.Event == ev.Type && ev.Value >= tr.Value {
+			if tr.Matches(ev) {
 				return tr.Next, true
 			}
 		}
 	}
 	return "", false
 }
+
+// Phase returns the phase with the given name.
+func (s *Scenario) Phase(name string) (Phase, bool) {
+	for _, p := range s.Phases {
+		if p.Name == name {
+			return p, true
+		}
+	}
+	return Phase{}, false
+}
+
+// Matches reports whether the event satisfies the trigger threshold. Triggers
+// with conditions never match a single event; use Eval instead.
+func (t Trigger) Matches(ev Event) bool {
+	return !t.Compound() && t.Event == ev.Type && ev.Value >= t.Value
+}
+
+// Compound reports whether the trigger has metric conditions.
+func (t Trigger) Compound() bool {
+	return len(t.All) > 0 || len(t.Any) > 0
+}
+
+// Eval reports whether the trigger fires for the given metrics. The event
+// threshold reads the metric named after the event.
+func (t Trigger) Eval(m Metrics) bool {
+	if t.Event != "" && m[t.Event] < float64(t.Value) {
+		return false
+	}
+	if !t.Compound() {
+		return t.Event != ""
+	}
+	return evalGroups(t.All, t.Any, m)
+}
+
+// String renders the trigger condition, e.g. "time_elapsed >= 60 && active_drones < 10".
+func (t Trigger) String() string {
+	var parts []string
+	if t.Event != "" {
+		parts = append(parts, fmt.Sprintf("%s >= %d", t.Event, t.Value))
+	}
+	for _, c := range t.All {
+		parts = append(parts, c.String())
+	}
+	if len(t.Any) > 0 {
+		parts = append(parts, formatGroups(nil, t.Any))
+	}
+	return strings.Join(parts, " && ")
+}
+
+// Resolve loads a scenario from a YAML file or, when no such file exists,
+// returns the built-in story arc with that name.
+func Resolve(nameOrPath string) (*Scenario, error) {
+	if _, err := os.Stat(nameOrPath); err == nil {
+		sc, err := Load(nameOrPath)
+		if err != nil {
+			return nil, err
+		}
+		if sc.Name == "" {
+			sc.Name = strings.TrimSuffix(filepath.Base(nameOrPath), filepath.Ext(nameOrPath))
+		}
+		return sc, nil
+	}
+	arc, ok := BuiltIn()[nameOrPath]
+	if !ok {
+		return nil, fmt.Errorf("scenario %q: no such file or built-in arc", nameOrPath)
+	}
+	return &arc, nil
+}
@@ -40,7 +40,8 @@ func TestLoadScenario(t *testing.T) {
 func TestBuiltInArcs(t *testing.T) {
 	arcs := BuiltIn()
 	names := []string{"escort", "search-and-rescue", "defensive-stand"}
-	phases := []string{"setup", "escalation", "climax", "resolution"}
+	// arcs with a failure outcome end in an extra failure phase
+	failures := map[string]bool{"escort": true, "defensive-stand": true}
 	for _, n := range names {
 		arc, ok := arcs[n]
 		if !ok {
@@ -49,6 +50,10 @@ func TestBuiltInArcs(t *testing.T) {
 		if arc.Description == "" {
 			t.Fatalf("arc %s missing description", n)
 		}
+		phases := []string{"setup", "escalation", "climax", "resolution"}
+		if failures[n] {
+			phases = append(phases, "failure")
+		}
 		if len(arc.Phases) != len(phases) {
 			t.Fatalf("arc %s expected %d phases, got %d", n, len(phases), len(arc.Phases))
 		}
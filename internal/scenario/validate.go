@@ -0,0 +1,329 @@
+package scenario
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	cueerrors "cuelang.org/go/cue/errors"
+	"gopkg.in/yaml.v3"
+
+	"droneops-sim/internal/config"
+	"droneops-sim/internal/enemy"
+	"droneops-sim/internal/telemetry"
+)
+
+// KnownEvents lists the trigger event types the simulator produces.
+var KnownEvents = []string{EventTimeElapsed, EventEnemyDestroyed, EventSurvivorFound, EventSurvivorExtracted, EventConvoyArrived, EventConvoyDestroyed, EventAssetDestroyed}
+
+// KnownMetrics lists the fixed metric names conditions may refer to. Event
+// types, the per-status and per-type counts and asset health are accepted as well.
+var KnownMetrics = []string{MetricActiveDrones, MetricTotalDrones, MetricAvgBattery, MetricEnemyCount, MetricFollowers}
+
+var knownActions = []enemy.Action{enemy.ActionAttack, enemy.ActionHarass, enemy.ActionRetreat, enemy.ActionPatrol}
+
+var (
+	droneStatuses = []string{telemetry.StatusOK, telemetry.StatusLowBattery, telemetry.StatusFailure}
+	enemyTypes    = []enemy.EnemyType{enemy.EnemyVehicle, enemy.EnemyPerson, enemy.EnemyDrone}
+)
+
+// Issue describes a problem found in a scenario definition. Line and Column
+// are zero when the scenario was not read from a file.
+type Issue struct {
+	File    string
+	Line    int
+	Column  int
+	Path    string
+	Message string
+}
+
+func (i Issue) String() string {
+	if i.File == "" {
+		return fmt.Sprintf("%s: %s", i.Path, i.Message)
+	}
+	return fmt.Sprintf("%s:%d:%d: %s: %s", i.File, i.Line, i.Column, i.Path, i.Message)
+}
+
+// Lint performs semantic checks: phase names are unique, every trigger names
+// a known event or well-formed conditions and an existing next phase,
+// objective actions, spawned enemy types and fleet patterns are known and
+// every phase is reachable from the first one.
+func (s *Scenario) Lint() []Issue {
+	var issues []Issue
+	add := func(path, format string, args ...any) {
+		issues = append(issues, Issue{Path: path, Message: fmt.Sprintf(format, args...)})
+	}
+	if len(s.Phases) == 0 {
+		add("phases", "scenario has no phases")
+		return issues
+	}
+
+	index := make(map[string]int, len(s.Phases))
+	for i, p := range s.Phases {
+		if prev, ok := index[p.Name]; ok {
+			add(fmt.Sprintf("phases[%d].name", i), "duplicate phase %q, first defined at phases[%d]", p.Name, prev)
+			continue
+		}
+		index[p.Name] = i
+	}
+
+	for i, p := range s.Phases {
+		for j, eo := range p.EnemyObjectives {
+			if !knownAction(eo.Action) {
+				add(fmt.Sprintf("phases[%d].enemy_objectives[%d].action", i, j), "unknown action %q (want %s)", eo.Action, joinActions())
+			}
+		}
+		for j, es := range p.Spawn.Enemies {
+			path := fmt.Sprintf("phases[%d].spawn.enemies[%d]", i, j)
+			if !knownEnemyType(es.Type) {
+				add(path+".type", "unknown enemy type %q", es.Type)
+			}
+			if es.Point != nil && es.Region != "" {
+				add(path, "spawn sets both point and region")
+			}
+			if es.Objective != nil && !knownAction(es.Objective.Action) {
+				add(path+".objective.action", "unknown action %q (want %s)", es.Objective.Action, joinActions())
+			}
+		}
+		for j, fs := range p.Spawn.Fleets {
+			if _, ok := telemetry.LookupPattern(fs.Pattern); fs.Pattern != "" && !ok {
+				add(fmt.Sprintf("phases[%d].spawn.fleets[%d].pattern", i, j), "unknown pattern %q (want %s)", fs.Pattern, strings.Join(telemetry.PatternNames(), ", "))
+			}
+		}
+		for j, tr := range p.Triggers {
+			path := fmt.Sprintf("phases[%d].triggers[%d]", i, j)
+			switch {
+			case tr.Event == "" && !tr.Compound():
+				add(path, "trigger needs an event or all/any conditions")
+			case tr.Event != "" && !knownEvent(tr.Event):
+				add(path+".event", "unknown event %q (want %s)", tr.Event, strings.Join(KnownEvents, ", "))
+			}
+			lintConditions(path+".all", tr.All, add)
+			lintConditions(path+".any", tr.Any, add)
+			if _, ok := index[tr.Next]; !ok {
+				add(fmt.Sprintf("phases[%d].triggers[%d].next", i, j), "unknown phase %q", tr.Next)
+			}
+		}
+	}
+
+	reached := map[string]bool{s.Phases[0].Name: true}
+	queue := []string{s.Phases[0].Name}
+	for len(queue) > 0 {
+		p, _ := s.Phase(queue[0])
+		queue = queue[1:]
+		for _, tr := range p.Triggers {
+			if _, ok := index[tr.Next]; ok && !reached[tr.Next] {
+				reached[tr.Next] = true
+				queue = append(queue, tr.Next)
+			}
+		}
+	}
+	for i, p := range s.Phases {
+		if !reached[p.Name] && index[p.Name] == i {
+			add(fmt.Sprintf("phases[%d]", i), "phase %q is unreachable from %q", p.Name, s.Phases[0].Name)
+		}
+	}
+	return issues
+}
+
+// lintConditions checks that every condition is either a comparison of a known
+// metric or a non-empty group, but not both.
+func lintConditions(path string, conds []Condition, add func(path, format string, args ...any)) {
+	for i, c := range conds {
+		cp := fmt.Sprintf("%s[%d]", path, i)
+		group := len(c.All) > 0 || len(c.Any) > 0
+		switch {
+		case c.Metric == "" && !group:
+			add(cp, "condition needs a metric or all/any conditions")
+		case c.Metric != "" && group:
+			add(cp, "condition mixes metric %q with all/any conditions", c.Metric)
+		case c.Metric != "":
+			if !knownMetric(c.Metric) {
+				add(cp+".metric", "unknown metric %q", c.Metric)
+			}
+			if !knownOp(c.Op) {
+				add(cp+".op", "unknown operator %q (want %s)", c.Op, strings.Join(knownOps, ", "))
+			}
+		}
+		lintConditions(cp+".all", c.All, add)
+		lintConditions(cp+".any", c.Any, add)
+	}
+}
+
+// Validate checks a scenario file against the CUE schema and, if that passes,
+// runs the semantic checks of Lint. Issues carry the file position they refer
+// to. The error is only set when the file cannot be read or parsed.
+func Validate(path, schemaPath string) ([]Issue, error) {
+	if err := config.ValidateWithCue(path, schemaPath); err != nil {
+		issues := cueIssues(path, err)
+		if len(issues) == 0 {
+			return nil, err
+		}
+		return issues, nil
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read scenario: %w", err)
+	}
+	var root yaml.Node
+	if err := yaml.Unmarshal(b, &root); err != nil {
+		return nil, fmt.Errorf("parse scenario: %w", err)
+	}
+	var s Scenario
+	if err := root.Decode(&s); err != nil {
+		return nil, fmt.Errorf("parse scenario: %w", err)
+	}
+	issues := s.Lint()
+	for i := range issues {
+		issues[i].File = path
+		if n := lookup(&root, issues[i].Path); n != nil {
+			issues[i].Line, issues[i].Column = n.Line, n.Column
+		}
+	}
+	return issues, nil
+}
+
+// cueIssues converts CUE validation errors into issues positioned in the scenario file.
+func cueIssues(path string, err error) []Issue {
+	var cueErr cueerrors.Error
+	if !errors.As(err, &cueErr) {
+		return nil
+	}
+	seen := make(map[string]bool)
+	var issues []Issue
+	for _, e := range cueerrors.Errors(cueErr) {
+		is := Issue{File: path, Path: formatCuePath(e.Path())}
+		for _, pos := range append(e.InputPositions(), e.Position()) {
+			if pos.IsValid() && pos.Filename() == path {
+				is.Line, is.Column = pos.Line(), pos.Column()
+				break
+			}
+		}
+		format, args := e.Msg()
+		is.Message = fmt.Sprintf(format, args...)
+		key := fmt.Sprintf("%d:%d:%s", is.Line, is.Column, is.Path)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		issues = append(issues, is)
+	}
+	return issues
+}
+
+// formatCuePath renders a CUE path like phases.0.name as phases[0].name.
+func formatCuePath(sel []string) string {
+	var b strings.Builder
+	for _, s := range sel {
+		if _, err := strconv.Atoi(s); err == nil {
+			b.WriteString("[" + s + "]")
+			continue
+		}
+		if b.Len() > 0 {
+			b.WriteByte('.')
+		}
+		b.WriteString(s)
+	}
+	return b.String()
+}
+
+// lookup resolves a path like phases[1].triggers[0].next in a YAML document.
+func lookup(root *yaml.Node, path string) *yaml.Node {
+	n := root
+	if n.Kind == yaml.DocumentNode && len(n.Content) > 0 {
+		n = n.Content[0]
+	}
+	for _, part := range strings.Split(path, ".") {
+		key, rest, _ := strings.Cut(part, "[")
+		if key != "" {
+			n = mappingValue(n, key)
+			if n == nil {
+				return nil
+			}
+		}
+		for rest != "" {
+			idxStr, after, _ := strings.Cut(rest, "]")
+			idx, err := strconv.Atoi(idxStr)
+			if err != nil || n.Kind != yaml.SequenceNode || idx >= len(n.Content) {
+				return nil
+			}
+			n = n.Content[idx]
+			rest = strings.TrimPrefix(after, "[")
+		}
+	}
+	return n
+}
+
+func mappingValue(n *yaml.Node, key string) *yaml.Node {
+	if n.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if n.Content[i].Value == key {
+			return n.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func knownEvent(ev string) bool {
+	return contains(KnownEvents, ev)
+}
+
+func knownMetric(name string) bool {
+	if knownEvent(name) || contains(KnownMetrics, name) {
+		return true
+	}
+	if status, ok := strings.CutPrefix(name, MetricDronesPrefix); ok && contains(droneStatuses, status) {
+		return true
+	}
+	if typ, ok := strings.CutPrefix(name, MetricEnemiesPrefix); ok && knownEnemyType(typ) {
+		return true
+	}
+	if id, ok := strings.CutSuffix(name, MetricHealthSuffix); ok && id != "" {
+		return true
+	}
+	return false
+}
+
+func knownOp(op string) bool {
+	return contains(knownOps, op)
+}
+
+func knownEnemyType(t string) bool {
+	for _, k := range enemyTypes {
+		if string(k) == t {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(list []string, s string) bool {
+	for _, k := range list {
+		if k == s {
+			return true
+		}
+	}
+	return false
+}
+
+func knownAction(a string) bool {
+	for _, k := range knownActions {
+		if string(k) == a {
+			return true
+		}
+	}
+	return false
+}
+
+func joinActions() string {
+	names := make([]string, len(knownActions))
+	for i, a := range knownActions {
+		names[i] = string(a)
+	}
+	return strings.Join(names, ", ")
+}
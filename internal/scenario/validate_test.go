@@ -0,0 +1,138 @@
+package scenario
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const schemaPath = "../../schemas/scenario.cue"
+
+func TestBuiltInArcsLintClean(t *testing.T) {
+	for name, arc := range BuiltIn() {
+		if issues := arc.Lint(); len(issues) != 0 {
+			t.Fatalf("arc %s has issues: %v", name, issues)
+		}
+	}
+}
+
+func TestSampleScenariosValidate(t *testing.T) {
+	files, err := filepath.Glob("../../config/scenario*.yaml")
+	if err != nil || len(files) == 0 {
+		t.Fatalf("no sample scenarios found: %v", err)
+	}
+	files = append(files, "testdata/simple.yaml")
+	for _, f := range files {
+		issues, err := Validate(f, schemaPath)
+		if err != nil {
+			t.Fatalf("%s: %v", f, err)
+		}
+		if len(issues) != 0 {
+			t.Fatalf("%s: unexpected issues %v", f, issues)
+		}
+	}
+}
+
+func TestValidateReportsPositions(t *testing.T) {
+	issues, err := Validate("testdata/invalid.yaml", schemaPath)
+	if err != nil {
+		t.Fatalf("validate: %v", err)
+	}
+	want := []string{
+		"testdata/invalid.yaml:5:16: phases[0].triggers[0].event: unknown event",
+		"testdata/invalid.yaml:11:17: phases[1].enemy_objectives[0].action: unknown action",
+		"testdata/invalid.yaml:12:5: phases[2]: phase \"orphan\" is unreachable",
+	}
+	if len(issues) != len(want) {
+		t.Fatalf("expected %d issues, got %v", len(want), issues)
+	}
+	for i, w := range want {
+		if !strings.HasPrefix(issues[i].String(), w) {
+			t.Fatalf("issue %d: expected prefix %q, got %q", i, w, issues[i])
+		}
+	}
+}
+
+func TestValidateSchemaErrors(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bad.yaml")
+	data := "phases:\n  - name: a\n    triggers:\n      - event: time_elapsed\n        value: -1\n        next: a\n"
+	if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	issues, err := Validate(path, schemaPath)
+	if err != nil {
+		t.Fatalf("validate: %v", err)
+	}
+	if len(issues) != 1 || issues[0].Line != 5 || issues[0].Path != "phases[0].triggers[0].value" {
+		t.Fatalf("unexpected issues %v", issues)
+	}
+}
+
+func TestLintUnknownNext(t *testing.T) {
+	s := &Scenario{Phases: []Phase{
+		{Name: "a", Triggers: []Trigger{{Event: "time_elapsed", Value: 1, Next: "typo"}}},
+	}}
+	issues := s.Lint()
+	if len(issues) != 1 || issues[0].Path != "phases[0].triggers[0].next" {
+		t.Fatalf("unexpected issues %v", issues)
+	}
+}
+
+func TestLintConditions(t *testing.T) {
+	s := &Scenario{Phases: []Phase{
+		{Name: "a", Triggers: []Trigger{
+			{Next: "b"},
+			{Any: []Condition{
+				{Metric: "drones_low_battery", Op: ">", Value: 2},
+				{Metric: "enemies_tank", Op: ">", Value: 0},
+				{All: []Condition{{Metric: "followers", Op: "=>", Value: 1}}},
+				{Metric: "enemy_count", Op: "==", All: []Condition{{Metric: "followers", Op: ">", Value: 1}}},
+				{Metric: "station_health", Op: "<", Value: 50},
+				{Metric: "_health", Op: "<", Value: 50},
+			}, Next: "b"},
+		}},
+		{Name: "b"},
+	}}
+	want := []string{
+		"phases[0].triggers[0]",
+		"phases[0].triggers[1].any[1].metric",
+		"phases[0].triggers[1].any[2].all[0].op",
+		"phases[0].triggers[1].any[3]",
+		"phases[0].triggers[1].any[5].metric",
+	}
+	issues := s.Lint()
+	if len(issues) != len(want) {
+		t.Fatalf("expected %d issues, got %v", len(want), issues)
+	}
+	for i, w := range want {
+		if issues[i].Path != w {
+			t.Fatalf("issue %d: expected path %s, got %v", i, w, issues[i])
+		}
+	}
+}
+
+func TestLintSpawns(t *testing.T) {
+	s := &Scenario{Phases: []Phase{{Name: "a", Spawn: Spawn{
+		Enemies: []EnemySpawn{
+			{Type: "tank", Count: 1},
+			{Type: "drone", Count: 1, Region: "z", Point: &Point{}, Objective: &SpawnObjective{Action: "charge"}},
+		},
+		Fleets: []FleetSpawn{{Model: "small-fpv", Count: 1, Pattern: "zigzag"}},
+	}}}}
+	want := []string{
+		"phases[0].spawn.enemies[0].type",
+		"phases[0].spawn.enemies[1]",
+		"phases[0].spawn.enemies[1].objective.action",
+		"phases[0].spawn.fleets[0].pattern",
+	}
+	issues := s.Lint()
+	if len(issues) != len(want) {
+		t.Fatalf("expected %d issues, got %v", len(want), issues)
+	}
+	for i, w := range want {
+		if issues[i].Path != w {
+			t.Fatalf("issue %d: expected path %s, got %v", i, w, issues[i])
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package sim
+
+import (
+	"fmt"
+	log "log/slog"
+
+	"droneops-sim/internal/asset"
+	"droneops-sim/internal/enemy"
+	"droneops-sim/internal/geo"
+	"droneops-sim/internal/scenario"
+)
+
+const (
+	defaultAssetRadiusM   = 200.0 // attack range around an asset when none is configured
+	defaultAssetHitPoints = 100.0 // asset hit points when none are configured
+	assetDamagePerEnemy   = 5.0   // hit points lost per attacking enemy and tick
+	interceptRangeM       = 200.0 // assigned followers this close to an enemy stop its attack
+)
+
+// AddAsset places a fixed asset. Missing IDs are generated and radius and
+// hit points fall back to defaults.
+func (s *Simulator) AddAsset(a asset.Asset) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.addAsset(a)
+}
+
+func (s *Simulator) addAsset(a asset.Asset) {
+	if a.ID == "" {
+		a.ID = fmt.Sprintf("asset-%d", len(s.assets))
+	}
+	if a.RadiusM <= 0 {
+		a.RadiusM = defaultAssetRadiusM
+	}
+	if a.MaxHitPoints <= 0 {
+		a.MaxHitPoints = a.HitPoints
+	}
+	if a.MaxHitPoints <= 0 {
+		a.MaxHitPoints = defaultAssetHitPoints
+	}
+	if a.HitPoints <= 0 {
+		a.HitPoints = a.MaxHitPoints
+	}
+	if a.Status == "" {
+		a.Status = asset.StatusOperational
+	}
+	s.assets = append(s.assets, &a)
+}
+
+// Assets returns a snapshot of all fixed assets.
+func (s *Simulator) Assets() []asset.Asset {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]asset.Asset, len(s.assets))
+	for i, a := range s.assets {
+		out[i] = *a
+	}
+	return out
+}
+
+// damageAssets applies damage from every active enemy with an attack
+// objective within an asset's radius, unless a drone intercepts it, and
+// returns one state row per asset. Destruction publishes asset_destroyed.
+func (s *Simulator) damageAssets() []asset.StateRow {
+	var rows []asset.StateRow
+	for _, a := range s.assets {
+		attackers := s.assetAttackers(a)
+		if a.Damage(assetDamagePerEnemy * float64(attackers)) {
+			s.logObserverEvent("asset_destroyed", "id="+a.ID+" owner="+a.Owner)
+			s.publish(scenario.EventAssetDestroyed, a.ID)
+		}
+		rows = append(rows, asset.StateRow{
+			ClusterID: s.clusterID,
+			AssetID:   a.ID,
+			Owner:     a.Owner,
+			Status:    a.Status,
+			Lat:       a.Position.Lat,
+			Lon:       a.Position.Lon,
+			Alt:       a.Position.Alt,
+			RadiusM:   a.RadiusM,
+			HitPoints: a.HitPoints,
+			Health:    a.Health(),
+			Attackers: attackers,
+			Timestamp: s.now().UTC(),
+		})
+	}
+	return rows
+}
+
+// assetAttackers counts active, unintercepted enemies with an attack
+// objective within the asset's radius.
+func (s *Simulator) assetAttackers(a *asset.Asset) int {
+	if s.enemyEng == nil || a.Status == asset.StatusDestroyed {
+		return 0
+	}
+	n := 0
+	for _, en := range s.enemyEng.Enemies {
+		if en.Status != enemy.EnemyActive {
+			continue
+		}
+		if obj, ok := s.enemyEng.ObjectiveFor(en); !ok || obj.Action != enemy.ActionAttack {
+			continue
+		}
+		if geo.Distance(a.Position.Lat, a.Position.Lon, en.Position.Lat, en.Position.Lon) > a.RadiusM {
+			continue
+		}
+		if s.intercepted(en) {
+			continue
+		}
+		n++
+	}
+	return n
+}
+
+// intercepted reports whether a drone assigned to follow the enemy is within
+// interceptRangeM of it.
+func (s *Simulator) intercepted(en *enemy.Enemy) bool {
+	for _, id := range s.enemyFollowers[en.ID] {
+		d := s.droneIndex[id]
+		if d == nil {
+			continue
+		}
+		if geo.Distance(d.Position.Lat, d.Position.Lon, en.Position.Lat, en.Position.Lon) <= interceptRangeM {
+			return true
+		}
+	}
+	return false
+}
+
+// writeAssets emits asset state rows when the writer supports them.
+func (s *Simulator) writeAssets(rows []asset.StateRow) {
+	if len(rows) == 0 {
+		return
+	}
+	aw, ok := s.writer.(AssetWriter)
+	if !ok {
+		return
+	}
+	if bw, ok := s.writer.(batchAssetWriter); ok {
+		if err := bw.WriteAssets(rows); err != nil {
+			log.Error("asset batch write failed", "err", err)
+		}
+		return
+	}
+	for _, r := range rows {
+		if err := aw.WriteAsset(r); err != nil {
+			log.Error("asset write failed", "asset_id", r.AssetID, "err", err)
+		}
+	}
+}
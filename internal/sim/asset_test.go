@@ -0,0 +1,76 @@
+package sim
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"droneops-sim/internal/asset"
+	"droneops-sim/internal/config"
+	"droneops-sim/internal/enemy"
+	"droneops-sim/internal/scenario"
+	"droneops-sim/internal/telemetry"
+)
+
+func TestAssetDamagedByAttackers(t *testing.T) {
+	cfg := &config.SimulationConfig{
+		Zones:       []config.Region{{Name: "z", RadiusKM: 1}},
+		Fleets:      []config.Fleet{{Name: "f", Model: "small-fpv", Count: 1, HomeRegion: "z"}},
+		FixedAssets: []config.FixedAsset{{ID: "station", Owner: "blue", Lat: 0.01, HitPoints: 20}},
+	}
+	sim := NewSimulator("c", cfg, &MockWriter{}, nil, time.Second, rand.New(rand.NewSource(1)), nil)
+	sim.enemyEng.Enemies = nil
+	var events []string
+	sim.Events().Subscribe(func(ev DomainEvent) { events = append(events, ev.Type+":"+ev.SubjectID) })
+	sim.SetScenario(&scenario.Scenario{Name: "hold", Phases: []scenario.Phase{
+		{Name: "defend", Triggers: []scenario.Trigger{{
+			All:  []scenario.Condition{{Metric: "station_health", Op: "<", Value: 50}},
+			Next: "fallback",
+		}}},
+		{Name: "fallback"},
+	}})
+
+	at := telemetry.Position{Lat: 0.0105}
+	sim.SpawnEnemy(enemy.Enemy{ID: "r1", Group: "raiders", Position: at})
+	sim.SpawnEnemy(enemy.Enemy{ID: "r2", Group: "raiders", Position: at})
+	sim.SpawnEnemy(enemy.Enemy{ID: "r3", Group: "raiders", Position: telemetry.Position{Lat: 0.02}})
+	sim.SpawnEnemy(enemy.Enemy{ID: "scout", Position: at})
+	sim.enemyEng.SetObjective("raiders", enemy.Objective{Action: enemy.ActionAttack, Target: "station"})
+
+	// a follower on top of r2 intercepts it
+	drone := sim.fleets[0].Drones[0]
+	drone.Position = at
+	sim.enemyFollowers["r2"] = []string{drone.ID}
+
+	rows := sim.damageAssets()
+	if len(rows) != 1 || rows[0].Attackers != 1 || rows[0].HitPoints != 15 || rows[0].Status != asset.StatusDamaged {
+		t.Fatalf("expected one attacker to deal damage, got %+v", rows)
+	}
+	if m := sim.scenarioMetrics(); m["station_health"] != 75 {
+		t.Fatalf("expected station_health 75, got %v", m["station_health"])
+	}
+
+	sim.damageAssets()
+	sim.damageAssets()
+	sim.advanceScenario()
+	if st := sim.ScenarioStatus(); st.Phase != "fallback" {
+		t.Fatalf("expected fallback phase, got %s", st.Phase)
+	}
+
+	// entering a phase replaces objectives, renew the attack order
+	sim.enemyEng.SetObjective("raiders", enemy.Objective{Action: enemy.ActionAttack, Target: "station"})
+	sim.damageAssets()
+	sim.damageAssets()
+	a := sim.Assets()[0]
+	if a.Status != asset.StatusDestroyed || a.HitPoints != 0 {
+		t.Fatalf("expected destroyed asset, got %+v", a)
+	}
+	if len(events) != 1 || events[0] != "asset_destroyed:station" {
+		t.Fatalf("expected one asset_destroyed event, got %v", events)
+	}
+
+	data := sim.MapSnapshot()
+	if len(data.Assets) != 1 || data.Assets[0].Status != asset.StatusDestroyed || data.Assets[0].RadiusM != defaultAssetRadiusM {
+		t.Fatalf("expected destroyed asset on map, got %+v", data.Assets)
+	}
+}
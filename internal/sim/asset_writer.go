@@ -0,0 +1,13 @@
+package sim
+
+import "droneops-sim/internal/asset"
+
+// AssetWriter handles fixed asset state rows.
+type AssetWriter interface {
+	WriteAsset(asset.StateRow) error
+}
+
+// Optional: asset writers may support batch mode.
+type batchAssetWriter interface {
+	WriteAssets([]asset.StateRow) error
+}
@@ -0,0 +1,151 @@
+package sim
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"droneops-sim/internal/config"
+	"droneops-sim/internal/telemetry"
+)
+
+const (
+	defaultChargeSlots = 2   // drones charging at once when a base sets no slots
+	defaultChargeRate  = 1.0 // percent per second when a base sets no rate
+)
+
+// baseStation recharges idle drones of the fleets stationed at it. Drones
+// hold a charge slot until they are full or launched.
+type baseStation struct {
+	ID       string
+	Position telemetry.Position
+	Slots    int
+	Rate     float64
+	charging []*telemetry.Drone
+}
+
+// addBaseStation registers a configured base station, applying default slots
+// and charge rate.
+func (s *Simulator) addBaseStation(b config.BaseStation) {
+	st := &baseStation{
+		ID:       b.ID,
+		Position: s.onTerrain(telemetry.Position{Lat: b.Lat, Lon: b.Lon, Alt: b.Alt}),
+		Slots:    b.ChargeSlots,
+		Rate:     b.ChargeRate,
+	}
+	if st.Slots <= 0 {
+		st.Slots = defaultChargeSlots
+	}
+	if st.Rate <= 0 {
+		st.Rate = defaultChargeRate
+	}
+	s.bases = append(s.bases, st)
+}
+
+// baseStation looks up a base station by ID.
+func (s *Simulator) baseStation(id string) *baseStation {
+	for _, b := range s.bases {
+		if b.ID == id {
+			return b
+		}
+	}
+	return nil
+}
+
+// chargeDrones recharges idle drones at their fleet's base station for dt.
+// Drones already charging keep their slot, free slots go to the waiting
+// drones with the lowest battery.
+func (s *Simulator) chargeDrones(dt time.Duration) {
+	for _, b := range s.bases {
+		var waiting []*telemetry.Drone
+		held := make(map[*telemetry.Drone]bool)
+		for _, d := range b.charging {
+			held[d] = true
+		}
+		for i := range s.fleets {
+			if s.fleets[i].Station != b.ID {
+				continue
+			}
+			for _, d := range s.fleets[i].Drones {
+				if d.Phase == telemetry.PhaseIdle && d.Battery < 100 && !d.Crashed && !held[d] {
+					waiting = append(waiting, d)
+				}
+			}
+		}
+
+		var charging []*telemetry.Drone
+		for _, d := range b.charging {
+			if d.Phase == telemetry.PhaseIdle && d.Battery < 100 && s.droneIndex[d.ID] == d {
+				charging = append(charging, d)
+			}
+		}
+		sort.SliceStable(waiting, func(i, j int) bool { return waiting[i].Battery < waiting[j].Battery })
+		for _, d := range waiting {
+			if len(charging) >= b.Slots {
+				break
+			}
+			charging = append(charging, d)
+		}
+		for _, d := range charging {
+			d.Battery += b.Rate * dt.Seconds()
+			if d.Battery > 100 {
+				d.Battery = 100
+			}
+		}
+		b.charging = charging
+	}
+}
+
+// rotateFleets launches idle drones that are charged to the launch threshold.
+// For missions with an on-station target, only as many drones as needed to
+// keep the target airborne are launched, fullest first; the others wait on
+// the ground as reserves.
+func (s *Simulator) rotateFleets() {
+	targets := make(map[string]int)
+	for _, m := range s.cfg.Missions {
+		if m.OnStation > 0 {
+			targets[m.ID] = m.OnStation
+		}
+	}
+	airborne := make(map[string]int)
+	ready := make(map[string][]*telemetry.Drone)
+	for i := range s.fleets {
+		for _, d := range s.fleets[i].Drones {
+			switch d.Phase {
+			case telemetry.PhaseTakeoff, telemetry.PhaseTransit, telemetry.PhaseOnStation:
+				if d.Status != telemetry.StatusFailure {
+					airborne[d.MissionID]++
+				}
+			case telemetry.PhaseIdle:
+				if d.Battery >= telemetry.LaunchBatteryThreshold && !d.Crashed {
+					ready[d.MissionID] = append(ready[d.MissionID], d)
+				}
+			}
+		}
+	}
+	// Missions launch in order so runs with the same seed repeat
+	missions := make([]string, 0, len(ready))
+	for mission := range ready {
+		missions = append(missions, mission)
+	}
+	sort.Strings(missions)
+	for _, mission := range missions {
+		drones := ready[mission]
+		target, ok := targets[mission]
+		if !ok {
+			for _, d := range drones {
+				d.Phase = telemetry.PhaseTakeoff
+			}
+			continue
+		}
+		sort.SliceStable(drones, func(i, j int) bool { return drones[i].Battery > drones[j].Battery })
+		for _, d := range drones {
+			if airborne[mission] >= target {
+				break
+			}
+			d.Phase = telemetry.PhaseTakeoff
+			airborne[mission]++
+			s.logObserverEvent("rotation_launch", fmt.Sprintf("drone=%s mission=%s battery=%.0f", d.ID, mission, d.Battery))
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package sim
+
+import (
+	"context"
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+
+	"droneops-sim/internal/config"
+	"droneops-sim/internal/telemetry"
+)
+
+func TestBaseStationChargesAndRotates(t *testing.T) {
+	cfg := &config.SimulationConfig{
+		Zones:        []config.Region{{Name: "z", RadiusKM: 1}},
+		Missions:     []config.Mission{{ID: "m", OnStation: 1}},
+		BaseStations: []config.BaseStation{{ID: "home", Lat: 0.01, ChargeSlots: 1, ChargeRate: 10}},
+		Fleets:       []config.Fleet{{Name: "f", Model: "small-fpv", Count: 3, MissionID: "m", HomeRegion: "z", BaseStation: "home"}},
+	}
+	sim := NewSimulator("c", cfg, &MockWriter{}, nil, time.Second, rand.New(rand.NewSource(1)), nil)
+	drones := sim.fleets[0].Drones
+	if drones[0].Phase != telemetry.PhaseIdle || drones[0].Position.Lat != 0.01 || drones[0].Base.Lat != 0.01 {
+		t.Fatalf("expected drone on the ground at its base station, got %+v", drones[0])
+	}
+
+	drones[0].Battery, drones[1].Battery, drones[2].Battery = 95, 50, 30
+	sim.rotateFleets()
+	if drones[0].Phase != telemetry.PhaseTakeoff || drones[1].Phase != telemetry.PhaseIdle {
+		t.Fatalf("expected only the charged drone to launch, got %s and %s", drones[0].Phase, drones[1].Phase)
+	}
+
+	// the single slot goes to the emptiest drone and is kept until it is full
+	sim.chargeDrones(time.Second)
+	if drones[2].Battery != 40 || drones[1].Battery != 50 {
+		t.Fatalf("expected emptiest drone to charge first, got %.0f and %.0f", drones[1].Battery, drones[2].Battery)
+	}
+	drones[1].Battery = 20
+	sim.chargeDrones(7 * time.Second)
+	if drones[2].Battery != 100 || drones[1].Battery != 20 {
+		t.Fatalf("expected charging drone to keep its slot, got %.0f and %.0f", drones[1].Battery, drones[2].Battery)
+	}
+	sim.chargeDrones(time.Second)
+	if drones[1].Battery != 30 {
+		t.Fatalf("expected freed slot to go to the waiting drone, got %.0f", drones[1].Battery)
+	}
+
+	// a returning drone is replaced by the fullest reserve
+	drones[0].Phase = telemetry.PhaseReturnToBase
+	sim.rotateFleets()
+	if drones[2].Phase != telemetry.PhaseTakeoff || drones[1].Phase != telemetry.PhaseIdle {
+		t.Fatalf("expected reserve to launch as replacement, got %s and %s", drones[2].Phase, drones[1].Phase)
+	}
+}
+
+func TestRotationLaunchesMissionsInOrder(t *testing.T) {
+	cfg := &config.SimulationConfig{
+		Zones:        []config.Region{{Name: "z", RadiusKM: 1}},
+		Missions:     []config.Mission{{ID: "c", OnStation: 1}, {ID: "a", OnStation: 1}, {ID: "b", OnStation: 1}},
+		BaseStations: []config.BaseStation{{ID: "home", ChargeSlots: 1, ChargeRate: 10}},
+	}
+	for _, m := range cfg.Missions {
+		cfg.Fleets = append(cfg.Fleets, config.Fleet{Name: m.ID, Model: "small-fpv", Count: 1, MissionID: m.ID, HomeRegion: "z", BaseStation: "home"})
+	}
+	for run := 0; run < 5; run++ {
+		sim := NewSimulator("c", cfg, &MockWriter{}, nil, time.Second, rand.New(rand.NewSource(1)), nil)
+		sim.rotateFleets()
+		var got []string
+		for _, ev := range sim.observerEvents {
+			if ev.Type == "rotation_launch" {
+				got = append(got, ev.Details[strings.Index(ev.Details, "mission="):strings.Index(ev.Details, " battery")])
+			}
+		}
+		if strings.Join(got, ",") != "mission=a,mission=b,mission=c" {
+			t.Fatalf("run %d: expected launches ordered by mission, got %v", run, got)
+		}
+	}
+}
+
+// discardWriter drops telemetry rows.
+type discardWriter struct{}
+
+func (discardWriter) Write(telemetry.TelemetryRow) error { return nil }
+
+func TestDefaultConfigReachesSteadyState(t *testing.T) {
+	cfg, err := config.Load("../../config/simulation.yaml", "../../schemas/simulation.cue")
+	if err != nil {
+		t.Fatalf("Load() returned error: %v", err)
+	}
+	for _, m := range cfg.Missions {
+		if m.OnStation <= 0 {
+			t.Fatalf("expected mission %s to set an on-station target", m.ID)
+		}
+	}
+	clock := time.Unix(0, 0)
+	tick := 5 * time.Second
+	sim := NewSimulator("c", cfg, discardWriter{}, nil, tick, rand.New(rand.NewSource(1)), func() time.Time { return clock })
+
+	// Average the drones kept airborne per mission over every hour of a 24h
+	// run, after the first hour has settled into rotation
+	hour := int(time.Hour / tick)
+	airborne := make(map[string]int)
+	for i := 1; i <= 24*hour; i++ {
+		clock = clock.Add(tick)
+		sim.tick(context.Background())
+		for _, f := range sim.fleets {
+			for _, d := range f.Drones {
+				switch d.Phase {
+				case telemetry.PhaseTakeoff, telemetry.PhaseTransit, telemetry.PhaseOnStation:
+					if !d.Crashed {
+						airborne[d.MissionID]++
+					}
+				}
+			}
+		}
+		if i%hour != 0 {
+			continue
+		}
+		for _, m := range cfg.Missions {
+			if avg := float64(airborne[m.ID]) / float64(hour); i > hour && avg < 0.8*float64(m.OnStation) {
+				t.Fatalf("hour %d: expected mission %s to keep %d drones airborne, got %.1f on average", i/hour, m.ID, m.OnStation, avg)
+			}
+		}
+		airborne = make(map[string]int)
+	}
+}
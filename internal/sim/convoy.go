@@ -0,0 +1,185 @@
+package sim
+
+import (
+	"fmt"
+	log "log/slog"
+
+	"droneops-sim/internal/convoy"
+	"droneops-sim/internal/enemy"
+	"droneops-sim/internal/geo"
+	"droneops-sim/internal/scenario"
+	"droneops-sim/internal/telemetry"
+)
+
+const (
+	defaultConvoySpeedMPS = 10.0  // convoy speed when none is configured
+	defaultConvoyHealth   = 100.0 // convoy health when none is configured
+	convoyThreatRadiusM   = 100.0 // active enemies this close damage a convoy
+	convoyDamagePerEnemy  = 2.0   // health lost per enemy in range and tick
+	escortRadiusM         = 150.0 // distance of escort formation slots from the convoy
+)
+
+// AddConvoy places a convoy at the start of its route. Missing IDs are
+// generated and speed and health fall back to defaults.
+func (s *Simulator) AddConvoy(c convoy.Convoy) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.addConvoy(c)
+}
+
+func (s *Simulator) addConvoy(c convoy.Convoy) {
+	if c.ID == "" {
+		c.ID = fmt.Sprintf("convoy-%d", len(s.convoys))
+	}
+	if c.SpeedMPS <= 0 {
+		c.SpeedMPS = defaultConvoySpeedMPS
+	}
+	if c.Health <= 0 {
+		c.Health = defaultConvoyHealth
+	}
+	if c.Status == "" {
+		c.Status = convoy.StatusEnRoute
+	}
+	if c.Position == (telemetry.Position{}) && len(c.Route) > 0 {
+		c.Position = c.Route[0]
+	}
+	s.convoys = append(s.convoys, &c)
+}
+
+// Convoys returns a snapshot of all convoys.
+func (s *Simulator) Convoys() []convoy.Convoy {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]convoy.Convoy, len(s.convoys))
+	for i, c := range s.convoys {
+		out[i] = *c
+		out[i].Route = append([]telemetry.Position(nil), c.Route...)
+	}
+	return out
+}
+
+// stepConvoys moves every convoy along its route, applies damage from active
+// enemies within convoyThreatRadiusM and returns one state row per convoy.
+// Arrival and destruction publish convoy_arrived and convoy_destroyed.
+func (s *Simulator) stepConvoys() []convoy.StateRow {
+	var rows []convoy.StateRow
+	for _, c := range s.convoys {
+		prev, wasEnRoute := c.Position, c.Status == convoy.StatusEnRoute
+		moved := c.Step(s.tickInterval)
+		if s.terrain != nil {
+			c.Position.Alt = s.elevation(c.Position.Lat, c.Position.Lon)
+		}
+		if wasEnRoute && c.Status == convoy.StatusArrived {
+			s.logObserverEvent("convoy_arrived", fmt.Sprintf("id=%s health=%.0f", c.ID, c.Health))
+			s.publish(scenario.EventConvoyArrived, c.ID)
+		}
+		if c.Damage(convoyDamagePerEnemy * float64(s.convoyThreats(c))) {
+			s.logObserverEvent("convoy_destroyed", "id="+c.ID)
+			s.publish(scenario.EventConvoyDestroyed, c.ID)
+		}
+		row := convoy.StateRow{
+			ClusterID: s.clusterID,
+			ConvoyID:  c.ID,
+			Status:    c.Status,
+			Lat:       c.Position.Lat,
+			Lon:       c.Position.Lon,
+			Alt:       c.Position.Alt,
+			Health:    c.Health,
+			Waypoint:  c.Waypoint,
+			Timestamp: s.now().UTC(),
+		}
+		if moved > 0 && s.tickInterval > 0 {
+			row.SpeedMPS = moved / s.tickInterval.Seconds()
+			row.HeadingDeg = geo.Bearing(prev.Lat, prev.Lon, c.Position.Lat, c.Position.Lon)
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// convoyThreats counts active enemies within convoyThreatRadiusM of the convoy.
+func (s *Simulator) convoyThreats(c *convoy.Convoy) int {
+	if s.enemyEng == nil {
+		return 0
+	}
+	n := 0
+	for _, en := range s.enemyEng.Enemies {
+		if en.Status != enemy.EnemyActive {
+			continue
+		}
+		if geo.Distance(c.Position.Lat, c.Position.Lon, en.Position.Lat, en.Position.Lon) <= convoyThreatRadiusM {
+			n++
+		}
+	}
+	return n
+}
+
+// assignEscortSlots spreads the working drones of escort fleets evenly on a
+// ring of escortRadiusM around the convoy they escort: the one named by the
+// fleet, or the first convoy.
+func (s *Simulator) assignEscortSlots() {
+	escorts := make(map[*convoy.Convoy][]*telemetry.Drone)
+	var order []*convoy.Convoy
+	for _, f := range s.fleets {
+		c := s.escortedConvoy(f.Escort)
+		for _, d := range f.Drones {
+			if d.MovementPattern != "escort" {
+				continue
+			}
+			d.FormationSlot = nil
+			if c == nil || d.Status == telemetry.StatusFailure {
+				continue
+			}
+			if _, ok := escorts[c]; !ok {
+				order = append(order, c)
+			}
+			escorts[c] = append(escorts[c], d)
+		}
+	}
+	for _, c := range order {
+		drones := escorts[c]
+		for i, d := range drones {
+			angle := 360 * float64(i) / float64(len(drones))
+			lat, lon := geo.Destination(c.Position.Lat, c.Position.Lon, angle, escortRadiusM)
+			d.FormationSlot = &telemetry.Position{Lat: lat, Lon: lon, Alt: d.Position.Alt}
+		}
+	}
+}
+
+// escortedConvoy resolves the convoy an escort fleet protects.
+func (s *Simulator) escortedConvoy(id string) *convoy.Convoy {
+	if len(s.convoys) == 0 {
+		return nil
+	}
+	if id == "" {
+		return s.convoys[0]
+	}
+	for _, c := range s.convoys {
+		if c.ID == id {
+			return c
+		}
+	}
+	return nil
+}
+
+// writeConvoys emits convoy state rows when the writer supports them.
+func (s *Simulator) writeConvoys(rows []convoy.StateRow) {
+	if len(rows) == 0 {
+		return
+	}
+	cw, ok := s.writer.(ConvoyWriter)
+	if !ok {
+		return
+	}
+	if bw, ok := s.writer.(batchConvoyWriter); ok {
+		if err := bw.WriteConvoys(rows); err != nil {
+			log.Error("convoy batch write failed", "err", err)
+		}
+		return
+	}
+	for _, r := range rows {
+		if err := cw.WriteConvoy(r); err != nil {
+			log.Error("convoy write failed", "convoy_id", r.ConvoyID, "err", err)
+		}
+	}
+}
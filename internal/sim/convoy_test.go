@@ -0,0 +1,112 @@
+package sim
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+
+	"droneops-sim/internal/config"
+	"droneops-sim/internal/convoy"
+	"droneops-sim/internal/enemy"
+	"droneops-sim/internal/geo"
+	"droneops-sim/internal/scenario"
+	"droneops-sim/internal/telemetry"
+)
+
+// convoyWriter records convoy state rows.
+type convoyWriter struct {
+	MockWriter
+	convoys []convoy.StateRow
+}
+
+func (w *convoyWriter) WriteConvoy(r convoy.StateRow) error {
+	w.convoys = append(w.convoys, r)
+	return nil
+}
+
+func TestConvoyEscortReachesDestination(t *testing.T) {
+	cfg := &config.SimulationConfig{
+		Zones: []config.Region{{Name: "z", CenterLat: 0, CenterLon: 0, RadiusKM: 1}},
+		Fleets: []config.Fleet{
+			{Name: "escort", Model: "small-fpv", Count: 3, HomeRegion: "z", MovementPattern: "escort"},
+			{Name: "scouts", Model: "small-fpv", Count: 1, HomeRegion: "z", MovementPattern: "patrol"},
+		},
+		Convoys: []config.Convoy{{
+			ID:       "convoy",
+			SpeedMPS: 20,
+			Route:    []config.Waypoint{{Lat: 0, Lon: 0}, {Lat: 0.001, Lon: 0}},
+		}},
+	}
+	w := &convoyWriter{}
+	sim := NewSimulator("c", cfg, w, nil, time.Second, rand.New(rand.NewSource(1)), nil)
+	sim.enemyEng.Enemies = nil
+	sim.SetScenario(&scenario.Scenario{Name: "escort", Phases: []scenario.Phase{
+		{Name: "transit", Triggers: []scenario.Trigger{
+			{Event: "convoy_arrived", Value: 1, Next: "success"},
+			{Event: "convoy_destroyed", Value: 1, Next: "failure"},
+		}},
+		{Name: "success"},
+		{Name: "failure"},
+	}})
+
+	for i := 0; i < 8; i++ {
+		sim.tick(context.Background())
+	}
+	c := sim.Convoys()[0]
+	if c.Status != convoy.StatusArrived || c.Position != c.Destination() {
+		t.Fatalf("expected convoy at destination, got %+v", c)
+	}
+	if st := sim.ScenarioStatus(); st.Phase != "success" {
+		t.Fatalf("expected success phase, got %s", st.Phase)
+	}
+	if len(w.convoys) != 8 || w.convoys[0].SpeedMPS != 20 || w.convoys[7].Status != convoy.StatusArrived {
+		t.Fatalf("unexpected convoy rows %+v", w.convoys)
+	}
+
+	for _, d := range sim.fleets[0].Drones {
+		if d.FormationSlot == nil {
+			t.Fatalf("expected escort %s to have a formation slot", d.ID)
+		}
+		dist := geo.Distance(d.Position.Lat, d.Position.Lon, c.Position.Lat, c.Position.Lon)
+		if dist > escortRadiusM+50 {
+			t.Fatalf("expected escort %s near the convoy, got %.0fm", d.ID, dist)
+		}
+	}
+	if sim.fleets[1].Drones[0].FormationSlot != nil {
+		t.Fatalf("expected patrol drone without formation slot")
+	}
+
+	data := sim.MapSnapshot()
+	if len(data.Convoys) != 1 || data.Convoys[0].ID != "convoy" || data.Convoys[0].Status != convoy.StatusArrived {
+		t.Fatalf("expected convoy on map, got %+v", data.Convoys)
+	}
+}
+
+func TestConvoyDestroyedByNearbyEnemies(t *testing.T) {
+	cfg := &config.SimulationConfig{Zones: []config.Region{{Name: "z", RadiusKM: 1}}}
+	sim := NewSimulator("c", cfg, &MockWriter{}, nil, time.Second, rand.New(rand.NewSource(1)), nil)
+	sim.enemyEng.Enemies = nil
+	sim.AddConvoy(convoy.Convoy{ID: "convoy", Health: 5, Route: []telemetry.Position{{}, {Lat: 1}}})
+	var events []string
+	sim.Events().Subscribe(func(ev DomainEvent) { events = append(events, ev.Type+":"+ev.SubjectID) })
+
+	sim.SpawnEnemy(enemy.Enemy{ID: "near", Position: telemetry.Position{Lat: 0.0005}})
+	sim.SpawnEnemy(enemy.Enemy{ID: "far", Position: telemetry.Position{Lat: 0.01}})
+	sim.SpawnEnemy(enemy.Enemy{ID: "dead", Position: telemetry.Position{Lat: 0.0005}, Status: enemy.EnemyNeutralized})
+
+	rows := sim.stepConvoys()
+	if rows[0].Health != 5-convoyDamagePerEnemy {
+		t.Fatalf("expected damage from one enemy, got health %f", rows[0].Health)
+	}
+	sim.stepConvoys()
+	sim.stepConvoys()
+	c := sim.Convoys()[0]
+	if c.Status != convoy.StatusDestroyed || c.Health != 0 {
+		t.Fatalf("expected destroyed convoy, got %+v", c)
+	}
+	sim.stepConvoys()
+	if len(events) != 1 || events[0] != "convoy_destroyed:convoy" {
+		t.Fatalf("expected one convoy_destroyed event, got %v", events)
+	}
+}
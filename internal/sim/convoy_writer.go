@@ -0,0 +1,13 @@
+package sim
+
+import "droneops-sim/internal/convoy"
+
+// ConvoyWriter handles convoy state rows.
+type ConvoyWriter interface {
+	WriteConvoy(convoy.StateRow) error
+}
+
+// Optional: convoy writers may support batch mode.
+type batchConvoyWriter interface {
+	WriteConvoys([]convoy.StateRow) error
+}
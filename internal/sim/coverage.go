@@ -0,0 +1,73 @@
+package sim
+
+import (
+	"droneops-sim/internal/config"
+	"droneops-sim/internal/coverage"
+	"droneops-sim/internal/telemetry"
+)
+
+// searchRegion returns the region a search fleet splits into lanes: the
+// region of its mission, or its home zone if the mission has none.
+func (s *Simulator) searchRegion(fleet config.Fleet, zone config.Region) telemetry.Region {
+	r := zone
+	for _, m := range s.cfg.Missions {
+		if m.ID == fleet.MissionID && m.Region.RadiusKM > 0 {
+			r = m.Region
+			break
+		}
+	}
+	return r.Telemetry()
+}
+
+// coverageGrid returns the coverage grid of a mission, creating it over region
+// with cells of cellM meters on first use.
+func (s *Simulator) coverageGrid(missionID string, region telemetry.Region, cellM float64) *coverage.Grid {
+	for _, g := range s.coverage {
+		if g.MissionID == missionID {
+			return g
+		}
+	}
+	center := telemetry.Position{Lat: region.CenterLat, Lon: region.CenterLon}
+	g := coverage.New(missionID, center, region.RadiusKM*1000, cellM)
+	if region.Shape != nil {
+		g.Clip(region.Contains)
+	}
+	s.coverage = append(s.coverage, g)
+	return g
+}
+
+// markCoverage records the track a search drone flew since prev on the
+// coverage grid of its mission.
+func (s *Simulator) markCoverage(drone *telemetry.Drone, prev telemetry.Position) {
+	f := s.droneFleet[drone.ID]
+	if f == nil || f.Swath <= 0 || !drone.OnTask() || !drone.Airborne() {
+		return
+	}
+	for _, g := range s.coverage {
+		if g.MissionID == drone.MissionID {
+			g.Mark(prev, drone.Position, f.Swath, s.now())
+		}
+	}
+}
+
+// coverageStats summarizes the coverage grid of every mission searched.
+func (s *Simulator) coverageStats() []telemetry.CoverageStat {
+	var stats []telemetry.CoverageStat
+	now := s.now()
+	for _, g := range s.coverage {
+		stats = append(stats, g.Stats(now))
+	}
+	return stats
+}
+
+// CoverageHeatmaps returns the coverage heatmap of every mission searched.
+func (s *Simulator) CoverageHeatmaps() []coverage.Heatmap {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	heatmaps := []coverage.Heatmap{}
+	now := s.now()
+	for _, g := range s.coverage {
+		heatmaps = append(heatmaps, g.Heatmap(now))
+	}
+	return heatmaps
+}
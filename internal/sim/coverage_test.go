@@ -0,0 +1,65 @@
+package sim
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+
+	"droneops-sim/internal/config"
+	"droneops-sim/internal/geo"
+	"droneops-sim/internal/telemetry"
+)
+
+func TestSearchFleetBuildsCoverage(t *testing.T) {
+	cfg := &config.SimulationConfig{
+		Zones:    []config.Region{{Name: "z", CenterLat: 48.2, CenterLon: 16.4, RadiusKM: 5}},
+		Missions: []config.Mission{{ID: "m", Region: config.Region{Name: "r", CenterLat: 48.2, CenterLon: 16.4, RadiusKM: 1}}},
+		Fleets: []config.Fleet{{
+			Name: "f", Model: "medium-uav", Count: 2, MovementPattern: "search", HomeRegion: "z", MissionID: "m",
+			SwathWidthM: 250,
+		}},
+	}
+	w := &MockStateWriter{}
+	now := time.Unix(0, 0)
+	sim := NewSimulator("c", cfg, w, nil, time.Second, rand.New(rand.NewSource(1)), func() time.Time { return now })
+	drones := sim.fleets[0].Drones
+	if len(drones[0].Waypoints) == 0 || drones[0].RouteMode != telemetry.RoutePingPong || drones[0].Waypoints[0] == drones[1].Waypoints[0] {
+		t.Fatalf("expected own ping-pong search lanes, got %+v and %+v", drones[0].Waypoints, drones[1].Waypoints)
+	}
+	if len(sim.coverage) != 1 || sim.coverage[0].RadiusM != 1000 {
+		t.Fatalf("expected one grid over the mission region, got %+v", sim.coverage)
+	}
+
+	for i := 0; i < 5; i++ {
+		now = now.Add(time.Second)
+		sim.tick(context.Background())
+	}
+	if len(w.Rows) == 0 || len(w.Rows[len(w.Rows)-1].Coverage) != 1 {
+		t.Fatalf("expected coverage in the simulation state, got %+v", w.Rows)
+	}
+	st := w.Rows[len(w.Rows)-1].Coverage[0]
+	if st.MissionID != "m" || st.CoveragePct <= 0 || st.MaxRevisitS > 5 {
+		t.Fatalf("expected search drones to cover part of the region, got %+v", st)
+	}
+	h := sim.CoverageHeatmaps()
+	if len(h) != 1 || h[0].CoveragePct != st.CoveragePct || len(h[0].Cells) == 0 {
+		t.Fatalf("expected heatmap matching the state, got %+v", h)
+	}
+}
+
+func TestCoverageGridClipsToPolygon(t *testing.T) {
+	half := geo.MultiPolygon{{geo.Ring{{Lat: 48.19, Lon: 16.39}, {Lat: 48.19, Lon: 16.4}, {Lat: 48.21, Lon: 16.4}, {Lat: 48.21, Lon: 16.39}}}}
+	region := telemetry.Region{Name: "west", CenterLat: 48.2, CenterLon: 16.4, RadiusKM: 1, Shape: half}
+	sim := &Simulator{}
+	g := sim.coverageGrid("m", region, 100)
+	cells := g.Heatmap(time.Unix(0, 0)).Cells
+	if len(cells) == 0 {
+		t.Fatalf("expected cells inside the polygon")
+	}
+	for _, c := range cells {
+		if !half.Contains(c.Lat, c.Lon) {
+			t.Fatalf("expected only cells inside the polygon, got %+v", c)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package sim
+
+import (
+	"sync"
+	"time"
+)
+
+// DomainEvent is a notable mission occurrence published on the EventBus.
+// Type uses the scenario event vocabulary, e.g. "enemy_destroyed".
+type DomainEvent struct {
+	Type      string
+	SubjectID string
+	Value     int
+	Timestamp time.Time
+}
+
+// EventBus delivers domain events to subscribers synchronously, in
+// subscription order, on the publishing goroutine.
+type EventBus struct {
+	mu   sync.Mutex
+	subs []func(DomainEvent)
+}
+
+// NewEventBus creates an empty event bus.
+func NewEventBus() *EventBus {
+	return &EventBus{}
+}
+
+// Subscribe registers a handler for all future events.
+func (b *EventBus) Subscribe(fn func(DomainEvent)) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.subs = append(b.subs, fn)
+}
+
+// Publish delivers the event to every subscriber.
+func (b *EventBus) Publish(ev DomainEvent) {
+	b.mu.Lock()
+	subs := make([]func(DomainEvent), len(b.subs))
+	copy(subs, b.subs)
+	b.mu.Unlock()
+	for _, fn := range subs {
+		fn(ev)
+	}
+}
@@ -0,0 +1,77 @@
+package sim
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+
+	"droneops-sim/internal/config"
+	"droneops-sim/internal/enemy"
+	"droneops-sim/internal/scenario"
+)
+
+func TestEventBusDeliversInOrder(t *testing.T) {
+	bus := NewEventBus()
+	var got []string
+	bus.Subscribe(func(ev DomainEvent) { got = append(got, "a:"+ev.Type) })
+	bus.Subscribe(func(ev DomainEvent) { got = append(got, "b:"+ev.Type) })
+	bus.Publish(DomainEvent{Type: "x"})
+	if len(got) != 2 || got[0] != "a:x" || got[1] != "b:x" {
+		t.Fatalf("unexpected delivery %v", got)
+	}
+}
+
+func TestSimulatorPublishesDomainEvents(t *testing.T) {
+	cfg := &config.SimulationConfig{
+		Zones:  []config.Region{{Name: "z", CenterLat: 0, CenterLon: 0, RadiusKM: 1}},
+		Fleets: []config.Fleet{{Name: "f", Model: "small-fpv", Count: 1}},
+	}
+	sim := NewSimulator("c", cfg, nil, nil, time.Second, rand.New(rand.NewSource(1)), func() time.Time { return time.Unix(0, 0).UTC() })
+	var got []DomainEvent
+	sim.Events().Subscribe(func(ev DomainEvent) { got = append(got, ev) })
+
+	sim.SpawnEnemy(enemy.Enemy{ID: "e1", Type: enemy.EnemyPerson})
+	sim.UpdateEnemyStatus("e1", enemy.EnemyNeutralized)
+	// already neutralized enemies do not count twice
+	sim.UpdateEnemyStatus("e1", enemy.EnemyNeutralized)
+	sim.ReportSurvivorFound("pilot")
+	sim.ReportSurvivorExtracted("pilot")
+
+	want := []string{scenario.EventEnemyDestroyed, scenario.EventSurvivorFound, scenario.EventSurvivorExtracted}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d events, got %+v", len(want), got)
+	}
+	for i, w := range want {
+		if got[i].Type != w || got[i].Value != 1 {
+			t.Fatalf("event %d: expected %s, got %+v", i, w, got[i])
+		}
+	}
+	if got[0].SubjectID != "e1" || got[1].SubjectID != "pilot" {
+		t.Fatalf("unexpected subjects %+v", got)
+	}
+}
+
+func TestSearchAndRescueArcProgresses(t *testing.T) {
+	cfg := &config.SimulationConfig{
+		Zones:  []config.Region{{Name: "z", CenterLat: 0, CenterLon: 0, RadiusKM: 1}},
+		Fleets: []config.Fleet{{Name: "f", Model: "small-fpv", Count: 1}},
+	}
+	now := time.Unix(0, 0).UTC()
+	sim := NewSimulator("c", cfg, &MockWriter{}, nil, time.Second, rand.New(rand.NewSource(1)), func() time.Time { return now })
+	arc := scenario.BuiltIn()["search-and-rescue"]
+	sim.SetScenario(&arc)
+
+	now = now.Add(20 * time.Second)
+	sim.tick(context.Background())
+	sim.ReportSurvivorFound("pilot")
+	sim.tick(context.Background())
+	if st := sim.ScenarioStatus(); st.Phase != "climax" {
+		t.Fatalf("expected climax, got %s", st.Phase)
+	}
+	sim.ReportSurvivorExtracted("pilot")
+	sim.tick(context.Background())
+	if st := sim.ScenarioStatus(); st.Phase != "resolution" {
+		t.Fatalf("expected resolution, got %s", st.Phase)
+	}
+}
@@ -0,0 +1,43 @@
+package sim
+
+import (
+	"droneops-sim/internal/poi"
+	"droneops-sim/internal/scenario"
+)
+
+// Events returns the simulator's domain event bus. Handlers run while the
+// simulator holds its lock and must not call back into the Simulator.
+func (s *Simulator) Events() *EventBus {
+	return s.events
+}
+
+// ReportSurvivorFound publishes a survivor_found event for the given survivor.
+// If id names a point of interest it is marked found, publishing only once.
+func (s *Simulator) ReportSurvivorFound(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if p := s.findPOI(id); p != nil {
+		s.setPOIStatus(p, poi.StatusFound)
+		return
+	}
+	s.publish(scenario.EventSurvivorFound, id)
+}
+
+// ReportSurvivorExtracted publishes a survivor_extracted event for the given survivor.
+// If id names a point of interest it is marked extracted, publishing only once.
+func (s *Simulator) ReportSurvivorExtracted(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if p := s.findPOI(id); p != nil {
+		s.setPOIStatus(p, poi.StatusExtracted)
+		return
+	}
+	s.publish(scenario.EventSurvivorExtracted, id)
+}
+
+func (s *Simulator) publish(eventType, subjectID string) {
+	if s.events == nil {
+		return
+	}
+	s.events.Publish(DomainEvent{Type: eventType, SubjectID: subjectID, Value: 1, Timestamp: s.now().UTC()})
+}
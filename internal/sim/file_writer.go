@@ -4,7 +4,10 @@ import (
 	"encoding/json"
 	"os"
 
+	"droneops-sim/internal/asset"
+	"droneops-sim/internal/convoy"
 	"droneops-sim/internal/enemy"
+	"droneops-sim/internal/poi"
 	"droneops-sim/internal/telemetry"
 )
 
@@ -14,14 +17,24 @@ type FileWriter struct {
 	detFile   *os.File
 	swarmFile *os.File
 	stateFile *os.File
+	phaseFile *os.File
+	poiFile   *os.File
+	convFile  *os.File
+	assetFile *os.File
+	geoFile   *os.File
 	teleEnc   *json.Encoder
 	detEnc    *json.Encoder
 	swarmEnc  *json.Encoder
 	stateEnc  *json.Encoder
+	phaseEnc  *json.Encoder
+	poiEnc    *json.Encoder
+	convEnc   *json.Encoder
+	assetEnc  *json.Encoder
+	geoEnc    *json.Encoder
 }
 
-// NewFileWriter creates a FileWriter. detectionPath, swarmPath, or statePath may be empty to skip those logs.
-func NewFileWriter(telemetryPath, detectionPath, swarmPath, statePath string) (*FileWriter, error) {
+// NewFileWriter creates a FileWriter. detectionPath, swarmPath, statePath, phasePath, poiPath, convoyPath, assetPath, or geofencePath may be empty to skip those logs.
+func NewFileWriter(telemetryPath, detectionPath, swarmPath, statePath, phasePath, poiPath, convoyPath, assetPath, geofencePath string) (*FileWriter, error) {
 	tf, err := os.Create(telemetryPath)
 	if err != nil {
 		return nil, err
@@ -63,6 +76,51 @@ func NewFileWriter(telemetryPath, detectionPath, swarmPath, statePath string) (*
 		fw.stateFile = sf
 		fw.stateEnc = json.NewEncoder(sf)
 	}
+	if phasePath != "" {
+		pf, err := os.Create(phasePath)
+		if err != nil {
+			fw.Close()
+			return nil, err
+		}
+		fw.phaseFile = pf
+		fw.phaseEnc = json.NewEncoder(pf)
+	}
+	if poiPath != "" {
+		pf, err := os.Create(poiPath)
+		if err != nil {
+			fw.Close()
+			return nil, err
+		}
+		fw.poiFile = pf
+		fw.poiEnc = json.NewEncoder(pf)
+	}
+	if convoyPath != "" {
+		cf, err := os.Create(convoyPath)
+		if err != nil {
+			fw.Close()
+			return nil, err
+		}
+		fw.convFile = cf
+		fw.convEnc = json.NewEncoder(cf)
+	}
+	if assetPath != "" {
+		af, err := os.Create(assetPath)
+		if err != nil {
+			fw.Close()
+			return nil, err
+		}
+		fw.assetFile = af
+		fw.assetEnc = json.NewEncoder(af)
+	}
+	if geofencePath != "" {
+		gf, err := os.Create(geofencePath)
+		if err != nil {
+			fw.Close()
+			return nil, err
+		}
+		fw.geoFile = gf
+		fw.geoEnc = json.NewEncoder(gf)
+	}
 	return fw, nil
 }
 
@@ -135,6 +193,96 @@ func (f *FileWriter) WriteStates(rows []telemetry.SimulationStateRow) error {
 	return nil
 }
 
+// WritePhase logs a scenario phase row, if enabled.
+func (f *FileWriter) WritePhase(row telemetry.ScenarioPhaseRow) error {
+	if f.phaseEnc == nil {
+		return nil
+	}
+	return f.phaseEnc.Encode(row)
+}
+
+// WritePhases logs multiple scenario phase rows.
+func (f *FileWriter) WritePhases(rows []telemetry.ScenarioPhaseRow) error {
+	for _, r := range rows {
+		if err := f.WritePhase(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WritePOIDetection logs a point of interest detection row, if enabled.
+func (f *FileWriter) WritePOIDetection(row poi.DetectionRow) error {
+	if f.poiEnc == nil {
+		return nil
+	}
+	return f.poiEnc.Encode(row)
+}
+
+// WritePOIDetections logs multiple point of interest detection rows.
+func (f *FileWriter) WritePOIDetections(rows []poi.DetectionRow) error {
+	for _, r := range rows {
+		if err := f.WritePOIDetection(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteConvoy logs a convoy state row, if enabled.
+func (f *FileWriter) WriteConvoy(row convoy.StateRow) error {
+	if f.convEnc == nil {
+		return nil
+	}
+	return f.convEnc.Encode(row)
+}
+
+// WriteConvoys logs multiple convoy state rows.
+func (f *FileWriter) WriteConvoys(rows []convoy.StateRow) error {
+	for _, r := range rows {
+		if err := f.WriteConvoy(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteAsset logs a fixed asset state row, if enabled.
+func (f *FileWriter) WriteAsset(row asset.StateRow) error {
+	if f.assetEnc == nil {
+		return nil
+	}
+	return f.assetEnc.Encode(row)
+}
+
+// WriteAssets logs multiple fixed asset state rows.
+func (f *FileWriter) WriteAssets(rows []asset.StateRow) error {
+	for _, r := range rows {
+		if err := f.WriteAsset(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteGeofenceBreach logs a geofence breach row, if enabled.
+func (f *FileWriter) WriteGeofenceBreach(row telemetry.GeofenceBreachRow) error {
+	if f.geoEnc == nil {
+		return nil
+	}
+	return f.geoEnc.Encode(row)
+}
+
+// WriteGeofenceBreaches logs multiple geofence breach rows.
+func (f *FileWriter) WriteGeofenceBreaches(rows []telemetry.GeofenceBreachRow) error {
+	for _, r := range rows {
+		if err := f.WriteGeofenceBreach(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // WriteMission logs a mission metadata row to the telemetry file.
 func (f *FileWriter) WriteMission(row telemetry.MissionRow) error {
 	return f.teleEnc.Encode(row)
@@ -173,5 +321,30 @@ func (f *FileWriter) Close() error {
 			err = e
 		}
 	}
+	if f.phaseFile != nil {
+		if e := f.phaseFile.Close(); e != nil && err == nil {
+			err = e
+		}
+	}
+	if f.poiFile != nil {
+		if e := f.poiFile.Close(); e != nil && err == nil {
+			err = e
+		}
+	}
+	if f.convFile != nil {
+		if e := f.convFile.Close(); e != nil && err == nil {
+			err = e
+		}
+	}
+	if f.assetFile != nil {
+		if e := f.assetFile.Close(); e != nil && err == nil {
+			err = e
+		}
+	}
+	if f.geoFile != nil {
+		if e := f.geoFile.Close(); e != nil && err == nil {
+			err = e
+		}
+	}
 	return err
 }
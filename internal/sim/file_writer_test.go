@@ -7,7 +7,10 @@ import (
 	"testing"
 	"time"
 
+	"droneops-sim/internal/asset"
+	"droneops-sim/internal/convoy"
 	"droneops-sim/internal/enemy"
+	"droneops-sim/internal/poi"
 	"droneops-sim/internal/telemetry"
 )
 
@@ -30,6 +33,11 @@ func TestFileWriter(t *testing.T) {
 	dRow := enemy.DetectionRow{ClusterID: "c1", DroneID: "d1", EnemyID: "e1", DistanceM: 10, Timestamp: ts}
 	sRow := telemetry.SwarmEventRow{ClusterID: "c1", EventType: telemetry.SwarmEventAssignment, DroneIDs: []string{"d1"}, EnemyID: "e1", Timestamp: ts}
 	stRow := telemetry.SimulationStateRow{ClusterID: "c1", MessagesSent: 1, ChaosMode: true, Timestamp: ts}
+	poiRow := poi.DetectionRow{ClusterID: "c1", DroneID: "d1", POIID: "s1", POIType: poi.Survivor, Status: poi.StatusFound, Confidence: 80, Timestamp: ts}
+	cRow := convoy.StateRow{ClusterID: "c1", ConvoyID: "convoy", Status: convoy.StatusArrived, Health: 60, Waypoint: 3, Timestamp: ts}
+	aRow := asset.StateRow{ClusterID: "c1", AssetID: "station", Owner: "blue", Status: asset.StatusDamaged, HitPoints: 40, Health: 40, Attackers: 2, Timestamp: ts}
+	gRow := telemetry.GeofenceBreachRow{ClusterID: "c1", DroneID: "d1", ZoneID: "airport", Event: telemetry.GeofenceEntry, PenetrationM: 12, Timestamp: ts}
+	pRow := telemetry.ScenarioPhaseRow{ClusterID: "c1", Scenario: "Escort", Phase: "setup", Transition: telemetry.PhaseTransitionExit, Event: "time_elapsed", Value: 30, Timestamp: ts}
 
 	cases := []struct {
 		name   string
@@ -93,12 +101,82 @@ func TestFileWriter(t *testing.T) {
 				}
 			},
 		},
+		{
+			name:  "phase",
+			path:  filepath.Join(dir, "phases.json"),
+			write: func(fw *FileWriter) error { return fw.WritePhase(pRow) },
+			decode: func(b []byte) {
+				var got telemetry.ScenarioPhaseRow
+				if err := json.Unmarshal(b, &got); err != nil {
+					t.Fatalf("decode phase: %v", err)
+				}
+				if got != pRow {
+					t.Fatalf("unexpected phase: %#v", got)
+				}
+			},
+		},
+		{
+			name:  "poi",
+			path:  filepath.Join(dir, "poi.json"),
+			write: func(fw *FileWriter) error { return fw.WritePOIDetection(poiRow) },
+			decode: func(b []byte) {
+				var got poi.DetectionRow
+				if err := json.Unmarshal(b, &got); err != nil {
+					t.Fatalf("decode poi: %v", err)
+				}
+				if got != poiRow {
+					t.Fatalf("unexpected poi detection: %#v", got)
+				}
+			},
+		},
+		{
+			name:  "convoy",
+			path:  filepath.Join(dir, "convoy.json"),
+			write: func(fw *FileWriter) error { return fw.WriteConvoy(cRow) },
+			decode: func(b []byte) {
+				var got convoy.StateRow
+				if err := json.Unmarshal(b, &got); err != nil {
+					t.Fatalf("decode convoy: %v", err)
+				}
+				if got != cRow {
+					t.Fatalf("unexpected convoy row: %#v", got)
+				}
+			},
+		},
+		{
+			name:  "asset",
+			path:  filepath.Join(dir, "assets.json"),
+			write: func(fw *FileWriter) error { return fw.WriteAsset(aRow) },
+			decode: func(b []byte) {
+				var got asset.StateRow
+				if err := json.Unmarshal(b, &got); err != nil {
+					t.Fatalf("decode asset: %v", err)
+				}
+				if got != aRow {
+					t.Fatalf("unexpected asset row: %#v", got)
+				}
+			},
+		},
+		{
+			name:  "geofence",
+			path:  filepath.Join(dir, "geofence.json"),
+			write: func(fw *FileWriter) error { return fw.WriteGeofenceBreach(gRow) },
+			decode: func(b []byte) {
+				var got telemetry.GeofenceBreachRow
+				if err := json.Unmarshal(b, &got); err != nil {
+					t.Fatalf("decode geofence: %v", err)
+				}
+				if got != gRow {
+					t.Fatalf("unexpected geofence row: %#v", got)
+				}
+			},
+		},
 	}
 
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			tele := filepath.Join(dir, tc.name+"_tele.json")
-			var det, swarm, state string
+			var det, swarm, state, phase, poiPath, convoyPath, assetPath, geofencePath string
 			switch tc.name {
 			case "telemetry":
 				tele = tc.path
@@ -108,8 +186,18 @@ func TestFileWriter(t *testing.T) {
 				swarm = tc.path
 			case "state":
 				state = tc.path
+			case "phase":
+				phase = tc.path
+			case "poi":
+				poiPath = tc.path
+			case "convoy":
+				convoyPath = tc.path
+			case "asset":
+				assetPath = tc.path
+			case "geofence":
+				geofencePath = tc.path
 			}
-			fw, err := NewFileWriter(tele, det, swarm, state)
+			fw, err := NewFileWriter(tele, det, swarm, state, phase, poiPath, convoyPath, assetPath, geofencePath)
 			if err != nil {
 				t.Fatalf("NewFileWriter: %v", err)
 			}
@@ -2,26 +2,30 @@ package sim
 
 import (
 	log "log/slog"
-	"math"
 
 	"droneops-sim/internal/enemy"
+	"droneops-sim/internal/geo"
 	"droneops-sim/internal/telemetry"
 )
 
 func (s *Simulator) logSwarmEvent(eventType string, drones []string, enemyID string) {
-	if len(drones) == 0 || !s.enableSwarmEvents {
-		return
-	}
-	w, ok := s.writer.(SwarmEventWriter)
-	if !ok {
-		return
-	}
-	row := telemetry.SwarmEventRow{
+	s.writeSwarmEvent(telemetry.SwarmEventRow{
 		ClusterID: s.clusterID,
 		EventType: eventType,
 		DroneIDs:  drones,
 		EnemyID:   enemyID,
 		Timestamp: s.now(),
+	})
+}
+
+// writeSwarmEvent emits a swarm event that involves drones, if enabled.
+func (s *Simulator) writeSwarmEvent(row telemetry.SwarmEventRow) {
+	if len(row.DroneIDs) == 0 || !s.enableSwarmEvents {
+		return
+	}
+	w, ok := s.writer.(SwarmEventWriter)
+	if !ok {
+		return
 	}
 	if err := w.WriteSwarmEvent(row); err != nil {
 		log.Error("swarm event write failed", "err", err)
@@ -36,12 +40,14 @@ func droneIDSlice(ds []*telemetry.Drone) []string {
 	return ids
 }
 
-func (s *Simulator) sendCommand() bool {
+// sendCommand sends a command to the drone within the bandwidth limit. The
+// command is lost at the communication loss at the drone's position.
+func (s *Simulator) sendCommand(d *telemetry.Drone) bool {
 	if s.bandwidthLimit > 0 && s.messagesSent >= s.bandwidthLimit {
 		return false
 	}
 	s.messagesSent++
-	if s.rand.Float64() < s.commLoss {
+	if s.rand.Float64() < s.conditionsAt(d.Position).CommLoss {
 		return false
 	}
 	return true
@@ -72,7 +78,7 @@ func (s *Simulator) selectReplacement() *telemetry.Drone {
 	var best *telemetry.Drone
 	for _, f := range s.fleets {
 		for _, d := range f.Drones {
-			if d.Status != telemetry.StatusOK || d.FollowTarget != nil {
+			if d.Status != telemetry.StatusOK || d.FollowTarget != nil || !d.OnTask() {
 				continue
 			}
 			if _, assigned := s.droneAssignments[d.ID]; assigned {
@@ -91,7 +97,7 @@ func (s *Simulator) cleanupFollowers(enemyID string, followers []string) []strin
 	active := followers[:0]
 	for _, id := range followers {
 		d := s.droneIndex[id]
-		if d != nil && d.FollowTarget != nil && d.Status == telemetry.StatusOK {
+		if d != nil && d.FollowTarget != nil && d.Status == telemetry.StatusOK && d.OnTask() {
 			active = append(active, id)
 			continue
 		}
@@ -108,7 +114,7 @@ func (s *Simulator) selectCandidates(missing int) []*telemetry.Drone {
 	var cands []*telemetry.Drone
 	for missing > 0 {
 		cand := s.selectReplacement()
-		if cand == nil || !s.sendCommand() {
+		if cand == nil || !s.sendCommand(cand) {
 			break
 		}
 		s.droneAssignments[cand.ID] = "" // reserve to avoid reselection
@@ -118,11 +124,11 @@ func (s *Simulator) selectCandidates(missing int) []*telemetry.Drone {
 	return cands
 }
 
-// filterSendable reserves and returns drones that can receive a command.
+// filterSendable reserves and returns drones on task that can receive a command.
 func (s *Simulator) filterSendable(cands []*telemetry.Drone) []*telemetry.Drone {
 	var selected []*telemetry.Drone
 	for _, c := range cands {
-		if s.sendCommand() {
+		if c.OnTask() && s.sendCommand(c) {
 			s.droneAssignments[c.ID] = "" // reserve
 			selected = append(selected, c)
 		}
@@ -265,38 +271,37 @@ func (s *Simulator) interceptPoints(en *enemy.Enemy, n int) []telemetry.Position
 	points := make([]telemetry.Position, n)
 	target := en.Position
 	prev, ok := s.enemyPrevPositions[en.ID]
-	velLat := 0.0
-	velLon := 0.0
+	moved, heading := 0.0, 0.0
 	if ok {
-		velLat = target.Lat - prev.Lat
-		velLon = target.Lon - prev.Lon
+		moved = geo.Distance(prev.Lat, prev.Lon, target.Lat, target.Lon)
+		heading = geo.Bearing(prev.Lat, prev.Lon, target.Lat, target.Lon)
 	}
-	predicted := telemetry.Position{Lat: target.Lat + velLat*5, Lon: target.Lon + velLon*5, Alt: target.Alt}
+	predicted := target
+	predicted.Lat, predicted.Lon = geo.Destination(target.Lat, target.Lon, heading, moved*5)
 	if n == 1 {
 		points[0] = predicted
 		return points
 	}
-	norm := math.Hypot(velLat, velLon)
-	var perpLat, perpLon float64
-	if norm != 0 {
-		perpLat = -velLon / norm
-		perpLon = velLat / norm
-	}
 	lateral := interceptLateralMeters
-	latStep := lateral / 111000
-	lonStep := lateral / (111000 * math.Cos(predicted.Lat*math.Pi/180))
+	if moved == 0 {
+		lateral = 0 // Without a track there is no side to spread to
+	}
 	for i := 0; i < n; i++ {
 		offset := float64(i) - float64(n-1)/2
-		points[i] = telemetry.Position{
-			Lat: predicted.Lat + offset*perpLat*latStep,
-			Lon: predicted.Lon + offset*perpLon*lonStep,
-			Alt: predicted.Alt,
-		}
+		points[i] = predicted
+		points[i].Lat, points[i].Lon = geo.Destination(predicted.Lat, predicted.Lon, heading+90, offset*lateral)
 	}
 	return points
 }
 
+// rebalanceFormation regroups the drones of a fleet that do not follow an
+// enemy. Fleets with a formation re-form around their leader, the others
+// spread their home-region centers on a circle.
 func (s *Simulator) rebalanceFormation(fleet *DroneFleet) {
+	if fleet.Formation != "" {
+		s.updateFormation(fleet, true)
+		return
+	}
 	var remaining []*telemetry.Drone
 	for _, d := range fleet.Drones {
 		if d.FollowTarget == nil {
@@ -310,11 +315,8 @@ func (s *Simulator) rebalanceFormation(fleet *DroneFleet) {
 	region := remaining[0].HomeRegion
 	radius := region.RadiusKM * 1000 * 0.5
 	for i, d := range remaining {
-		angle := float64(i) / float64(n) * 2 * math.Pi
-		deltaLat := (radius * math.Cos(angle)) / 111000
-		deltaLon := (radius * math.Sin(angle)) / (111000 * math.Cos(region.CenterLat*math.Pi/180))
-		d.HomeRegion.CenterLat = region.CenterLat + deltaLat
-		d.HomeRegion.CenterLon = region.CenterLon + deltaLon
+		angle := float64(i) / float64(n) * 360
+		d.HomeRegion.CenterLat, d.HomeRegion.CenterLon = geo.Destination(region.CenterLat, region.CenterLon, angle, radius)
 	}
 	s.logSwarmEvent(telemetry.SwarmEventFormationChange, droneIDSlice(remaining), "")
 }
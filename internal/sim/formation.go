@@ -0,0 +1,129 @@
+package sim
+
+import (
+	"math"
+
+	"droneops-sim/internal/geo"
+	"droneops-sim/internal/telemetry"
+)
+
+// Formation types.
+const (
+	FormationLineAbreast = "line_abreast" // Side by side, alternating right and left of the leader
+	FormationColumn      = "column"       // One behind the other
+	FormationWedge       = "wedge"        // V behind the leader
+	FormationBox         = "box"          // Rows of a square grid behind the leader
+	FormationRing        = "ring"         // Circle around the leader
+)
+
+// defaultFormationSpacingM separates formation slots when a fleet sets no spacing.
+const defaultFormationSpacingM = 50.0
+
+// assignFormationSlots flies the fleets with a formation around their
+// leader. Members are the working drones on task that do not follow an
+// enemy; a leader that drops out is replaced by the member with the most
+// battery. Changes of leader or membership emit a formation_change event.
+func (s *Simulator) assignFormationSlots() {
+	for i := range s.fleets {
+		f := &s.fleets[i]
+		if f.Formation == "" {
+			continue
+		}
+		s.updateFormation(f, false)
+	}
+}
+
+// updateFormation elects the fleet's leader and assigns the followers their
+// slots. The formation_change event is emitted on changes, or always when
+// force is set.
+func (s *Simulator) updateFormation(f *DroneFleet, force bool) {
+	var members []*telemetry.Drone
+	var leader *telemetry.Drone
+	for _, d := range f.Drones {
+		d.FormationSlot = nil
+		if d.Status == telemetry.StatusFailure || d.FollowTarget != nil || !d.OnTask() {
+			continue
+		}
+		members = append(members, d)
+		if d.ID == f.Leader {
+			leader = d
+		}
+	}
+	if leader == nil {
+		for _, d := range members {
+			if leader == nil || d.Battery > leader.Battery {
+				leader = d
+			}
+		}
+	}
+	leaderID := ""
+	if leader != nil {
+		leaderID = leader.ID
+	}
+	changed := leaderID != f.Leader || len(members) != f.members
+	f.Leader, f.members = leaderID, len(members)
+	if leader == nil {
+		return
+	}
+
+	spacing := f.Spacing
+	if spacing <= 0 {
+		spacing = defaultFormationSpacingM
+	}
+	rad := leader.HeadingDeg * math.Pi / 180
+	slot := 1
+	ordered := []*telemetry.Drone{leader}
+	for _, d := range members {
+		if d == leader {
+			continue
+		}
+		ordered = append(ordered, d)
+		fwd, right := formationOffset(f.Formation, slot, len(members), spacing)
+		north := fwd*math.Cos(rad) - right*math.Sin(rad)
+		east := fwd*math.Sin(rad) + right*math.Cos(rad)
+		lat, lon := geo.Offset(leader.Position.Lat, leader.Position.Lon, north, east)
+		d.FormationSlot = &telemetry.Position{Lat: lat, Lon: lon, Alt: leader.Position.Alt}
+		slot++
+	}
+	if changed || force {
+		s.logFormationChange(f, ordered)
+	}
+}
+
+// formationOffset returns the position of slot i of n, the leader being
+// slot 0, in meters ahead of and right of the leader.
+func formationOffset(formation string, i, n int, spacing float64) (fwd, right float64) {
+	k := float64((i + 1) / 2)
+	side := 1.0
+	if i%2 == 0 {
+		side = -1
+	}
+	switch formation {
+	case FormationLineAbreast:
+		return 0, side * k * spacing
+	case FormationColumn:
+		return -float64(i) * spacing, 0
+	case FormationWedge:
+		return -k * spacing, side * k * spacing
+	case FormationBox:
+		cols := int(math.Ceil(math.Sqrt(float64(n))))
+		return -float64(i/cols) * spacing, float64(i%cols) * spacing
+	case FormationRing:
+		angle := 2 * math.Pi * float64(i-1) / float64(max(n-1, 1))
+		return spacing * math.Cos(angle), spacing * math.Sin(angle)
+	}
+	return 0, 0
+}
+
+// logFormationChange emits a formation_change event for a fleet with a
+// formation, leader first.
+func (s *Simulator) logFormationChange(f *DroneFleet, members []*telemetry.Drone) {
+	s.writeSwarmEvent(telemetry.SwarmEventRow{
+		ClusterID: s.clusterID,
+		EventType: telemetry.SwarmEventFormationChange,
+		DroneIDs:  droneIDSlice(members),
+		Formation: f.Formation,
+		LeaderID:  f.Leader,
+		Timestamp: s.now().UTC(),
+	})
+}
@@ -0,0 +1,77 @@
+package sim
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+	"time"
+
+	"droneops-sim/internal/config"
+	"droneops-sim/internal/geo"
+	"droneops-sim/internal/telemetry"
+)
+
+func TestFormationOffsets(t *testing.T) {
+	cases := []struct {
+		formation  string
+		i          int
+		fwd, right float64
+	}{
+		{FormationLineAbreast, 1, 0, 10},
+		{FormationLineAbreast, 2, 0, -10},
+		{FormationColumn, 2, -20, 0},
+		{FormationWedge, 3, -20, 20},
+		{FormationWedge, 4, -20, -20},
+		{FormationBox, 4, -10, 10},
+		{FormationRing, 1, 10, 0},
+	}
+	for _, c := range cases {
+		fwd, right := formationOffset(c.formation, c.i, 5, 10)
+		if math.Abs(fwd-c.fwd) > 1e-9 || math.Abs(right-c.right) > 1e-9 {
+			t.Errorf("%s slot %d: expected (%.0f, %.0f), got (%.2f, %.2f)", c.formation, c.i, c.fwd, c.right, fwd, right)
+		}
+	}
+}
+
+func TestFormationFollowsLeaderAndReelects(t *testing.T) {
+	cfg := &config.SimulationConfig{
+		Zones: []config.Region{{Name: "z", RadiusKM: 1}},
+		Fleets: []config.Fleet{{
+			Name: "f", Model: "small-fpv", Count: 3, MovementPattern: "patrol", HomeRegion: "z",
+			Formation: FormationColumn, FormationSpacingM: 30,
+		}},
+	}
+	w := &mockSwarmWriter{}
+	sim := NewSimulator("c", cfg, w, nil, time.Second, rand.New(rand.NewSource(1)), nil)
+	drones := sim.fleets[0].Drones
+	drones[1].Battery = 90
+	drones[2].Battery = 95
+	drones[0].HeadingDeg = 90
+
+	sim.assignFormationSlots()
+	if sim.fleets[0].Leader != drones[0].ID || drones[0].FormationSlot != nil {
+		t.Fatalf("expected fullest drone to lead without a slot, got leader %q", sim.fleets[0].Leader)
+	}
+	slot := drones[1].FormationSlot
+	if slot == nil || math.Abs(geo.Distance(drones[0].Position.Lat, drones[0].Position.Lon, slot.Lat, slot.Lon)-30) > 0.5 ||
+		math.Abs(geo.Bearing(drones[0].Position.Lat, drones[0].Position.Lon, slot.Lat, slot.Lon)-270) > 0.5 {
+		t.Fatalf("expected first follower 30 m behind the eastbound leader, got %+v", slot)
+	}
+	if len(w.events) != 1 || w.events[0].Formation != FormationColumn || w.events[0].LeaderID != drones[0].ID {
+		t.Fatalf("expected one formation_change event with leader, got %+v", w.events)
+	}
+
+	sim.assignFormationSlots()
+	if len(w.events) != 1 {
+		t.Fatalf("expected no event without changes, got %d", len(w.events))
+	}
+
+	drones[0].FollowTarget = &telemetry.Position{Lat: 0.01}
+	sim.assignFormationSlots()
+	if sim.fleets[0].Leader != drones[2].ID || drones[1].FormationSlot == nil {
+		t.Fatalf("expected fullest remaining drone elected leader, got %q", sim.fleets[0].Leader)
+	}
+	if last := w.events[len(w.events)-1]; len(w.events) != 2 || last.LeaderID != drones[2].ID || len(last.DroneIDs) != 2 {
+		t.Fatalf("expected formation_change for the new leader, got %+v", w.events)
+	}
+}
@@ -0,0 +1,125 @@
+package sim
+
+import (
+	log "log/slog"
+
+	"droneops-sim/internal/config"
+	"droneops-sim/internal/telemetry"
+)
+
+// elapsed returns the seconds since the simulation started.
+func (s *Simulator) elapsed() float64 {
+	return s.now().Sub(s.started).Seconds()
+}
+
+// airspace returns the restricted area as avoided by drones, its floor and
+// ceiling above the terrain.
+func (s *Simulator) airspace(a config.RestrictedArea) telemetry.Airspace {
+	as := a.Airspace()
+	if s.terrain != nil {
+		as.Terrain = s.terrain
+	}
+	return as
+}
+
+// updateAirspace hands every drone the restricted areas in force, which
+// their movement patterns route around.
+func (s *Simulator) updateAirspace(drones []*telemetry.Drone) {
+	var active []telemetry.Airspace
+	elapsed := s.elapsed()
+	for _, a := range s.cfg.RestrictedAreas {
+		if a.Active(elapsed) {
+			active = append(active, s.airspace(a))
+		}
+	}
+	for _, d := range drones {
+		d.NoFly = active
+	}
+}
+
+// checkGeofence flags a telemetry row reported inside restricted airspace
+// and returns the breaches it starts or ends. The reported position counts,
+// so sensor errors breach as well as drones that flew in. An area that goes
+// out of force ends the breaches inside it.
+func (s *Simulator) checkGeofence(drone *telemetry.Drone, row *telemetry.TelemetryRow) []telemetry.GeofenceBreachRow {
+	var rows []telemetry.GeofenceBreachRow
+	pos := telemetry.Position{Lat: row.Lat, Lon: row.Lon, Alt: row.Alt}
+	elapsed := s.elapsed()
+	for _, a := range s.cfg.RestrictedAreas {
+		key := drone.ID + "|" + a.ID
+		depth, inside := s.breaches[key]
+		airspace := s.airspace(a)
+		in := a.Active(elapsed) && airspace.Contains(pos)
+		if in {
+			row.ZoneBreach = true
+		}
+		event := ""
+		switch {
+		case in && !inside:
+			depth = airspace.Depth(pos)
+			s.breaches[key] = depth
+			event = telemetry.GeofenceEntry
+		case in:
+			s.breaches[key] = max(depth, airspace.Depth(pos))
+		case inside:
+			delete(s.breaches, key)
+			event = telemetry.GeofenceExit
+		}
+		if event == "" {
+			continue
+		}
+		rows = append(rows, telemetry.GeofenceBreachRow{
+			ClusterID:    s.clusterID,
+			DroneID:      drone.ID,
+			ZoneID:       a.ID,
+			Event:        event,
+			PenetrationM: depth,
+			Lat:          row.Lat,
+			Lon:          row.Lon,
+			Alt:          row.Alt,
+			Timestamp:    s.now().UTC(),
+		})
+	}
+	return rows
+}
+
+// writeGeofenceBreaches emits geofence breach rows when the writer supports them.
+func (s *Simulator) writeGeofenceBreaches(rows []telemetry.GeofenceBreachRow) {
+	if len(rows) == 0 {
+		return
+	}
+	gw, ok := s.writer.(GeofenceWriter)
+	if !ok {
+		return
+	}
+	if bw, ok := s.writer.(batchGeofenceWriter); ok {
+		if err := bw.WriteGeofenceBreaches(rows); err != nil {
+			log.Error("geofence batch write failed", "err", err)
+		}
+		return
+	}
+	for _, r := range rows {
+		if err := gw.WriteGeofenceBreach(r); err != nil {
+			log.Error("geofence write failed", "drone_id", r.DroneID, "zone_id", r.ZoneID, "err", err)
+		}
+	}
+}
+
+// mapRestrictedAreas returns the restricted areas for the map views.
+func (s *Simulator) mapRestrictedAreas() []MapRestrictedArea {
+	var areas []MapRestrictedArea
+	elapsed := s.elapsed()
+	for _, a := range s.cfg.RestrictedAreas {
+		lat, lon, _ := a.Shape.BoundingCircle()
+		areas = append(areas, MapRestrictedArea{
+			ID:       a.ID,
+			Lat:      lat,
+			Lon:      lon,
+			FloorM:   a.FloorM,
+			CeilingM: a.CeilingM,
+			Active:   a.Active(elapsed),
+			Polygons: mapPolygons(a.Shape),
+		})
+	}
+	return areas
+}
@@ -0,0 +1,61 @@
+package sim
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"droneops-sim/internal/config"
+	"droneops-sim/internal/geo"
+	"droneops-sim/internal/telemetry"
+)
+
+func TestGeofenceBreachEntryAndExit(t *testing.T) {
+	start := time.Unix(0, 0)
+	now := start
+	cfg := &config.SimulationConfig{
+		Zones:  []config.Region{{Name: "z", CenterLat: 48.2, CenterLon: 16.4, RadiusKM: 5}},
+		Fleets: []config.Fleet{{Name: "f", Model: "medium-uav", Count: 1, MovementPattern: "loiter", HomeRegion: "z"}},
+		RestrictedAreas: []config.RestrictedArea{{
+			ID:           "airport",
+			Shape:        geo.MultiPolygon{{geo.Ring{{Lat: 48.19, Lon: 16.41}, {Lat: 48.19, Lon: 16.43}, {Lat: 48.21, Lon: 16.43}, {Lat: 48.21, Lon: 16.41}}}},
+			ActiveFromS:  10,
+			ActiveUntilS: 100,
+		}},
+	}
+	sim := NewSimulator("c", cfg, &mockSwarmWriter{}, nil, time.Second, rand.New(rand.NewSource(1)), func() time.Time { return now })
+	drone := sim.fleets[0].Drones[0]
+	edge := &telemetry.TelemetryRow{Lat: 48.2, Lon: 16.429, Alt: 100}
+	deep := &telemetry.TelemetryRow{Lat: 48.2, Lon: 16.42, Alt: 100}
+	outside := &telemetry.TelemetryRow{Lat: 48.2, Lon: 16.44, Alt: 100}
+
+	sim.updateAirspace([]*telemetry.Drone{drone})
+	if rows := sim.checkGeofence(drone, deep); len(rows) != 0 || deep.ZoneBreach || drone.NoFly != nil {
+		t.Fatalf("expected no breach before the area is active, got %+v", rows)
+	}
+
+	now = start.Add(10 * time.Second)
+	sim.updateAirspace([]*telemetry.Drone{drone})
+	if len(drone.NoFly) != 1 {
+		t.Fatalf("expected the active area handed to the drone, got %+v", drone.NoFly)
+	}
+	rows := sim.checkGeofence(drone, edge)
+	if len(rows) != 1 || rows[0].Event != telemetry.GeofenceEntry || rows[0].ZoneID != "airport" || rows[0].PenetrationM > 100 || !edge.ZoneBreach {
+		t.Fatalf("expected an entry near the edge, got %+v", rows)
+	}
+	deep.ZoneBreach = false
+	if rows := sim.checkGeofence(drone, deep); len(rows) != 0 || !deep.ZoneBreach {
+		t.Fatalf("expected the breach to continue without event, got %+v", rows)
+	}
+	rows = sim.checkGeofence(drone, outside)
+	if len(rows) != 1 || rows[0].Event != telemetry.GeofenceExit || rows[0].PenetrationM < 700 || outside.ZoneBreach {
+		t.Fatalf("expected an exit with the deepest penetration, got %+v", rows)
+	}
+
+	sim.checkGeofence(drone, deep)
+	now = start.Add(100 * time.Second)
+	rows = sim.checkGeofence(drone, deep)
+	if len(rows) != 1 || rows[0].Event != telemetry.GeofenceExit {
+		t.Fatalf("expected the area going out of force to end the breach, got %+v", rows)
+	}
+}
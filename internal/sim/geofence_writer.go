@@ -0,0 +1,13 @@
+package sim
+
+import "droneops-sim/internal/telemetry"
+
+// GeofenceWriter handles geofence breach rows.
+type GeofenceWriter interface {
+	WriteGeofenceBreach(telemetry.GeofenceBreachRow) error
+}
+
+// Optional: geofence writers may support batch mode.
+type batchGeofenceWriter interface {
+	WriteGeofenceBreaches([]telemetry.GeofenceBreachRow) error
+}
@@ -6,7 +6,10 @@ import (
 	log "log/slog"
 	"time"
 
+	"droneops-sim/internal/asset"
+	"droneops-sim/internal/convoy"
 	"droneops-sim/internal/enemy"
+	"droneops-sim/internal/poi"
 	"droneops-sim/internal/telemetry"
 
 	gpb "github.com/GreptimeTeam/greptime-proto/go/greptime/v1"
@@ -29,10 +32,15 @@ type GreptimeDBWriter struct {
 	swarmTable     string
 	stateTable     string
 	missionTable   string
+	phaseTable     string
+	poiTable       string
+	convoyTable    string
+	assetTable     string
+	geofenceTable  string
 }
 
 // NewGreptimeDBWriter creates a new GreptimeDB writer.
-func NewGreptimeDBWriter(endpoint, database, table string, detectionTable string, swarmTable string, stateTable string, missionTable string) (*GreptimeDBWriter, error) {
+func NewGreptimeDBWriter(endpoint, database, table string, detectionTable string, swarmTable string, stateTable string, missionTable string, phaseTable string, poiTable string, convoyTable string, assetTable string, geofenceTable string) (*GreptimeDBWriter, error) {
 	cfg := greptime.NewConfig(endpoint).
 		WithPort(4001).
 		WithDatabase(database)
@@ -58,6 +66,21 @@ func NewGreptimeDBWriter(endpoint, database, table string, detectionTable string
 	if missionTable == "" {
 		missionTable = "missions"
 	}
+	if phaseTable == "" {
+		phaseTable = "scenario_phases"
+	}
+	if poiTable == "" {
+		poiTable = "poi_detections"
+	}
+	if convoyTable == "" {
+		convoyTable = "convoy_state"
+	}
+	if assetTable == "" {
+		assetTable = "asset_state"
+	}
+	if geofenceTable == "" {
+		geofenceTable = "geofence_breach"
+	}
 
 	return &GreptimeDBWriter{
 		client:         client,
@@ -67,6 +90,11 @@ func NewGreptimeDBWriter(endpoint, database, table string, detectionTable string
 		swarmTable:     swarmTable,
 		stateTable:     stateTable,
 		missionTable:   missionTable,
+		phaseTable:     phaseTable,
+		poiTable:       poiTable,
+		convoyTable:    convoyTable,
+		assetTable:     assetTable,
+		geofenceTable:  geofenceTable,
 	}, nil
 }
 
@@ -93,12 +121,17 @@ func (w *GreptimeDBWriter) WriteBatch(rows []telemetry.TelemetryRow) error {
 	tbl.AddFieldColumn("lat", types.FLOAT64)
 	tbl.AddFieldColumn("lon", types.FLOAT64)
 	tbl.AddFieldColumn("alt", types.FLOAT64)
+	tbl.AddFieldColumn("alt_agl", types.FLOAT64)
 	tbl.AddFieldColumn("battery", types.FLOAT64)
 	tbl.AddFieldColumn("status", types.STRING)
 	tbl.AddFieldColumn("follow", types.BOOLEAN)
 	tbl.AddFieldColumn("movement_pattern", types.STRING)
 	tbl.AddFieldColumn("speed_mps", types.FLOAT64)
+	tbl.AddFieldColumn("airspeed_mps", types.FLOAT64)
 	tbl.AddFieldColumn("heading_deg", types.FLOAT64)
+	tbl.AddFieldColumn("waypoint_index", types.INT64)
+	tbl.AddFieldColumn("phase", types.STRING)
+	tbl.AddFieldColumn("zone_breach", types.BOOLEAN)
 	tbl.AddFieldColumn("previous_position", types.STRING)
 	tbl.AddFieldColumn("synced_from", types.STRING)
 	tbl.AddFieldColumn("synced_id", types.STRING)
@@ -114,12 +147,17 @@ func (w *GreptimeDBWriter) WriteBatch(rows []telemetry.TelemetryRow) error {
 			r.Lat,
 			r.Lon,
 			r.Alt,
+			r.AltAGL,
 			r.Battery,
 			r.Status,
 			r.Follow,
 			r.MovementPattern,
 			r.SpeedMPS,
+			r.AirspeedMPS,
 			r.HeadingDeg,
+			int64(r.WaypointIndex),
+			r.Phase,
+			r.ZoneBreach,
 			string(prevJSON),
 			r.SyncedFrom,
 			r.SyncedID,
@@ -207,6 +245,72 @@ func (w *GreptimeDBWriter) WriteDetections(rows []enemy.DetectionRow) error {
 	return nil
 }
 
+// WritePOIDetection inserts a single point of interest detection row.
+func (w *GreptimeDBWriter) WritePOIDetection(row poi.DetectionRow) error {
+	return w.WritePOIDetections([]poi.DetectionRow{row})
+}
+
+// WritePOIDetections inserts multiple point of interest detection rows.
+func (w *GreptimeDBWriter) WritePOIDetections(rows []poi.DetectionRow) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	ctx := context.Background()
+
+	tbl, err := table.New(w.poiTable)
+	if err != nil {
+		return err
+	}
+	tbl.AddTagColumn("cluster_id", types.STRING)
+	tbl.AddTagColumn("drone_id", types.STRING)
+	tbl.AddTagColumn("poi_id", types.STRING)
+	tbl.AddTagColumn("poi_type", types.STRING)
+	tbl.AddFieldColumn("status", types.STRING)
+	tbl.AddFieldColumn("lat", types.FLOAT64)
+	tbl.AddFieldColumn("lon", types.FLOAT64)
+	tbl.AddFieldColumn("alt", types.FLOAT64)
+	tbl.AddFieldColumn("drone_lat", types.FLOAT64)
+	tbl.AddFieldColumn("drone_lon", types.FLOAT64)
+	tbl.AddFieldColumn("drone_alt", types.FLOAT64)
+	tbl.AddFieldColumn("distance_m", types.FLOAT64)
+	tbl.AddFieldColumn("bearing_deg", types.FLOAT64)
+	tbl.AddFieldColumn("confidence", types.FLOAT64)
+	tbl.AddTimestampColumn("ts", types.TIMESTAMP_MILLISECOND)
+
+	for _, r := range rows {
+		err := tbl.AddRow(
+			r.ClusterID,
+			r.DroneID,
+			r.POIID,
+			string(r.POIType),
+			string(r.Status),
+			r.Lat,
+			r.Lon,
+			r.Alt,
+			r.DroneLat,
+			r.DroneLon,
+			r.DroneAlt,
+			r.DistanceM,
+			r.BearingDeg,
+			r.Confidence,
+			r.Timestamp,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = w.client.Write(ctx, tbl)
+	if err != nil {
+		log.Error("GreptimeDBWriter poi detection write failed", "err", err)
+		return err
+	}
+
+	log.Info("GreptimeDBWriter wrote poi detections", "count", len(rows))
+	return nil
+}
+
 // WriteSwarmEvent inserts a single swarm event row.
 func (w *GreptimeDBWriter) WriteSwarmEvent(e telemetry.SwarmEventRow) error {
 	return w.WriteSwarmEvents([]telemetry.SwarmEventRow{e})
@@ -228,6 +332,9 @@ func (w *GreptimeDBWriter) WriteSwarmEvents(rows []telemetry.SwarmEventRow) erro
 	tbl.AddTagColumn("event_type", types.STRING)
 	tbl.AddFieldColumn("drone_ids", types.JSON)
 	tbl.AddTagColumn("enemy_id", types.STRING)
+	tbl.AddFieldColumn("formation", types.STRING)
+	tbl.AddFieldColumn("leader_id", types.STRING)
+	tbl.AddFieldColumn("distance_m", types.FLOAT64)
 	tbl.AddTimestampColumn("ts", types.TIMESTAMP_MILLISECOND)
 
 	for _, r := range rows {
@@ -236,6 +343,9 @@ func (w *GreptimeDBWriter) WriteSwarmEvents(rows []telemetry.SwarmEventRow) erro
 			r.EventType,
 			r.DroneIDs,
 			r.EnemyID,
+			r.Formation,
+			r.LeaderID,
+			r.DistanceM,
 			r.Timestamp,
 		)
 		if err != nil {
@@ -275,7 +385,10 @@ func (w *GreptimeDBWriter) WriteStates(rows []telemetry.SimulationStateRow) erro
 	tbl.AddFieldColumn("messages_sent", types.INT64)
 	tbl.AddFieldColumn("sensor_noise", types.FLOAT64)
 	tbl.AddFieldColumn("weather_impact", types.FLOAT64)
+	tbl.AddFieldColumn("wind_direction_deg", types.FLOAT64)
+	tbl.AddFieldColumn("wind_speed_mps", types.FLOAT64)
 	tbl.AddFieldColumn("chaos_mode", types.BOOLEAN)
+	tbl.AddFieldColumn("coverage", types.JSON)
 	tbl.AddTimestampColumn("ts", types.TIMESTAMP_MILLISECOND)
 
 	for _, r := range rows {
@@ -285,7 +398,10 @@ func (w *GreptimeDBWriter) WriteStates(rows []telemetry.SimulationStateRow) erro
 			int64(r.MessagesSent),
 			r.SensorNoise,
 			r.WeatherImpact,
+			r.WindDirectionDeg,
+			r.WindSpeedMPS,
 			r.ChaosMode,
+			r.Coverage,
 			r.Timestamp,
 		)
 		if err != nil {
@@ -302,6 +418,224 @@ func (w *GreptimeDBWriter) WriteStates(rows []telemetry.SimulationStateRow) erro
 	return nil
 }
 
+// WritePhase inserts a single scenario phase row.
+func (w *GreptimeDBWriter) WritePhase(row telemetry.ScenarioPhaseRow) error {
+	return w.WritePhases([]telemetry.ScenarioPhaseRow{row})
+}
+
+// WritePhases inserts multiple scenario phase rows.
+func (w *GreptimeDBWriter) WritePhases(rows []telemetry.ScenarioPhaseRow) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	ctx := context.Background()
+
+	tbl, err := table.New(w.phaseTable)
+	if err != nil {
+		return err
+	}
+	tbl.AddTagColumn("cluster_id", types.STRING)
+	tbl.AddTagColumn("scenario", types.STRING)
+	tbl.AddTagColumn("phase", types.STRING)
+	tbl.AddTagColumn("transition", types.STRING)
+	tbl.AddFieldColumn("event", types.STRING)
+	tbl.AddFieldColumn("value", types.INT64)
+	tbl.AddTimestampColumn("ts", types.TIMESTAMP_MILLISECOND)
+
+	for _, r := range rows {
+		err := tbl.AddRow(
+			r.ClusterID,
+			r.Scenario,
+			r.Phase,
+			r.Transition,
+			r.Event,
+			int64(r.Value),
+			r.Timestamp,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = w.client.Write(ctx, tbl)
+	if err != nil {
+		log.Error("GreptimeDBWriter phase write failed", "err", err)
+		return err
+	}
+	log.Info("GreptimeDBWriter wrote phase rows", "count", len(rows))
+	return nil
+}
+
+// WriteConvoy inserts a single convoy state row.
+func (w *GreptimeDBWriter) WriteConvoy(row convoy.StateRow) error {
+	return w.WriteConvoys([]convoy.StateRow{row})
+}
+
+// WriteConvoys inserts multiple convoy state rows.
+func (w *GreptimeDBWriter) WriteConvoys(rows []convoy.StateRow) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	ctx := context.Background()
+
+	tbl, err := table.New(w.convoyTable)
+	if err != nil {
+		return err
+	}
+	tbl.AddTagColumn("cluster_id", types.STRING)
+	tbl.AddTagColumn("convoy_id", types.STRING)
+	tbl.AddFieldColumn("status", types.STRING)
+	tbl.AddFieldColumn("lat", types.FLOAT64)
+	tbl.AddFieldColumn("lon", types.FLOAT64)
+	tbl.AddFieldColumn("alt", types.FLOAT64)
+	tbl.AddFieldColumn("speed_mps", types.FLOAT64)
+	tbl.AddFieldColumn("heading_deg", types.FLOAT64)
+	tbl.AddFieldColumn("health", types.FLOAT64)
+	tbl.AddFieldColumn("waypoint", types.INT64)
+	tbl.AddTimestampColumn("ts", types.TIMESTAMP_MILLISECOND)
+
+	for _, r := range rows {
+		err := tbl.AddRow(
+			r.ClusterID,
+			r.ConvoyID,
+			string(r.Status),
+			r.Lat,
+			r.Lon,
+			r.Alt,
+			r.SpeedMPS,
+			r.HeadingDeg,
+			r.Health,
+			int64(r.Waypoint),
+			r.Timestamp,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = w.client.Write(ctx, tbl)
+	if err != nil {
+		log.Error("GreptimeDBWriter convoy write failed", "err", err)
+		return err
+	}
+	log.Info("GreptimeDBWriter wrote convoy rows", "count", len(rows))
+	return nil
+}
+
+// WriteAsset inserts a single fixed asset state row.
+func (w *GreptimeDBWriter) WriteAsset(row asset.StateRow) error {
+	return w.WriteAssets([]asset.StateRow{row})
+}
+
+// WriteAssets inserts multiple fixed asset state rows.
+func (w *GreptimeDBWriter) WriteAssets(rows []asset.StateRow) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	ctx := context.Background()
+
+	tbl, err := table.New(w.assetTable)
+	if err != nil {
+		return err
+	}
+	tbl.AddTagColumn("cluster_id", types.STRING)
+	tbl.AddTagColumn("asset_id", types.STRING)
+	tbl.AddTagColumn("owner", types.STRING)
+	tbl.AddFieldColumn("status", types.STRING)
+	tbl.AddFieldColumn("lat", types.FLOAT64)
+	tbl.AddFieldColumn("lon", types.FLOAT64)
+	tbl.AddFieldColumn("alt", types.FLOAT64)
+	tbl.AddFieldColumn("radius_m", types.FLOAT64)
+	tbl.AddFieldColumn("hit_points", types.FLOAT64)
+	tbl.AddFieldColumn("health", types.FLOAT64)
+	tbl.AddFieldColumn("attackers", types.INT64)
+	tbl.AddTimestampColumn("ts", types.TIMESTAMP_MILLISECOND)
+
+	for _, r := range rows {
+		err := tbl.AddRow(
+			r.ClusterID,
+			r.AssetID,
+			r.Owner,
+			string(r.Status),
+			r.Lat,
+			r.Lon,
+			r.Alt,
+			r.RadiusM,
+			r.HitPoints,
+			r.Health,
+			int64(r.Attackers),
+			r.Timestamp,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = w.client.Write(ctx, tbl)
+	if err != nil {
+		log.Error("GreptimeDBWriter asset write failed", "err", err)
+		return err
+	}
+	log.Info("GreptimeDBWriter wrote asset rows", "count", len(rows))
+	return nil
+}
+
+// WriteGeofenceBreach inserts a single geofence breach row.
+func (w *GreptimeDBWriter) WriteGeofenceBreach(row telemetry.GeofenceBreachRow) error {
+	return w.WriteGeofenceBreaches([]telemetry.GeofenceBreachRow{row})
+}
+
+// WriteGeofenceBreaches inserts multiple geofence breach rows.
+func (w *GreptimeDBWriter) WriteGeofenceBreaches(rows []telemetry.GeofenceBreachRow) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	ctx := context.Background()
+
+	tbl, err := table.New(w.geofenceTable)
+	if err != nil {
+		return err
+	}
+	tbl.AddTagColumn("cluster_id", types.STRING)
+	tbl.AddTagColumn("drone_id", types.STRING)
+	tbl.AddTagColumn("zone_id", types.STRING)
+	tbl.AddFieldColumn("event", types.STRING)
+	tbl.AddFieldColumn("penetration_m", types.FLOAT64)
+	tbl.AddFieldColumn("lat", types.FLOAT64)
+	tbl.AddFieldColumn("lon", types.FLOAT64)
+	tbl.AddFieldColumn("alt", types.FLOAT64)
+	tbl.AddTimestampColumn("ts", types.TIMESTAMP_MILLISECOND)
+
+	for _, r := range rows {
+		err := tbl.AddRow(
+			r.ClusterID,
+			r.DroneID,
+			r.ZoneID,
+			r.Event,
+			r.PenetrationM,
+			r.Lat,
+			r.Lon,
+			r.Alt,
+			r.Timestamp,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = w.client.Write(ctx, tbl)
+	if err != nil {
+		log.Error("GreptimeDBWriter geofence write failed", "err", err)
+		return err
+	}
+	log.Info("GreptimeDBWriter wrote geofence breach rows", "count", len(rows))
+	return nil
+}
+
 // WriteMission inserts a single mission metadata row.
 func (w *GreptimeDBWriter) WriteMission(row telemetry.MissionRow) error {
 	return w.WriteMissions([]telemetry.MissionRow{row})
@@ -8,6 +8,9 @@ import (
 	gpb "github.com/GreptimeTeam/greptime-proto/go/greptime/v1"
 	"github.com/GreptimeTeam/greptimedb-ingester-go/table"
 
+	"droneops-sim/internal/asset"
+	"droneops-sim/internal/convoy"
+	"droneops-sim/internal/poi"
 	"droneops-sim/internal/telemetry"
 )
 
@@ -84,3 +87,159 @@ func TestGreptimeWriterMissions(t *testing.T) {
 		t.Fatalf("region_name = %s, want R", got)
 	}
 }
+
+func TestGreptimeWriterPhases(t *testing.T) {
+	rows := []telemetry.ScenarioPhaseRow{{
+		ClusterID:  "c1",
+		Scenario:   "Escort",
+		Phase:      "climax",
+		Transition: telemetry.PhaseTransitionEnter,
+		Event:      "enemy_destroyed",
+		Value:      3,
+		Timestamp:  time.Unix(0, 0).UTC(),
+	}}
+
+	m := &mockGreptimeClient{}
+	w := &GreptimeDBWriter{client: m, phaseTable: "scenario_phases"}
+
+	if err := w.WritePhases(rows); err != nil {
+		t.Fatalf("WritePhases: %v", err)
+	}
+	if m.table == nil {
+		t.Fatalf("expected table to be captured")
+	}
+	vals := m.table.GetRows().Rows[0].Values
+	if got := vals[2].GetStringValue(); got != "climax" {
+		t.Fatalf("phase = %s, want climax", got)
+	}
+	if got := vals[5].GetI64Value(); got != 3 {
+		t.Fatalf("value = %d, want 3", got)
+	}
+}
+
+func TestGreptimeWriterPOIDetections(t *testing.T) {
+	rows := []poi.DetectionRow{{
+		ClusterID:  "c1",
+		DroneID:    "d1",
+		POIID:      "s1",
+		POIType:    poi.Survivor,
+		Status:     poi.StatusFound,
+		Confidence: 75,
+		Timestamp:  time.Unix(0, 0).UTC(),
+	}}
+
+	m := &mockGreptimeClient{}
+	w := &GreptimeDBWriter{client: m, poiTable: "poi_detections"}
+
+	if err := w.WritePOIDetections(rows); err != nil {
+		t.Fatalf("WritePOIDetections: %v", err)
+	}
+	if m.table == nil {
+		t.Fatalf("expected table to be captured")
+	}
+	vals := m.table.GetRows().Rows[0].Values
+	if got := vals[3].GetStringValue(); got != "survivor" {
+		t.Fatalf("poi_type = %s, want survivor", got)
+	}
+	if got := vals[4].GetStringValue(); got != "found" {
+		t.Fatalf("status = %s, want found", got)
+	}
+	if got := vals[13].GetF64Value(); got != 75 {
+		t.Fatalf("confidence = %f, want 75", got)
+	}
+}
+
+func TestGreptimeWriterConvoys(t *testing.T) {
+	rows := []convoy.StateRow{{
+		ClusterID: "c1",
+		ConvoyID:  "convoy",
+		Status:    convoy.StatusEnRoute,
+		Health:    80,
+		Waypoint:  2,
+		Timestamp: time.Unix(0, 0).UTC(),
+	}}
+
+	m := &mockGreptimeClient{}
+	w := &GreptimeDBWriter{client: m, convoyTable: "convoy_state"}
+
+	if err := w.WriteConvoys(rows); err != nil {
+		t.Fatalf("WriteConvoys: %v", err)
+	}
+	if m.table == nil {
+		t.Fatalf("expected table to be captured")
+	}
+	vals := m.table.GetRows().Rows[0].Values
+	if got := vals[2].GetStringValue(); got != "en_route" {
+		t.Fatalf("status = %s, want en_route", got)
+	}
+	if got := vals[8].GetF64Value(); got != 80 {
+		t.Fatalf("health = %f, want 80", got)
+	}
+	if got := vals[9].GetI64Value(); got != 2 {
+		t.Fatalf("waypoint = %d, want 2", got)
+	}
+}
+
+func TestGreptimeWriterAssets(t *testing.T) {
+	rows := []asset.StateRow{{
+		ClusterID: "c1",
+		AssetID:   "station",
+		Owner:     "blue",
+		Status:    asset.StatusDamaged,
+		HitPoints: 150,
+		Health:    75,
+		Attackers: 2,
+		Timestamp: time.Unix(0, 0).UTC(),
+	}}
+
+	m := &mockGreptimeClient{}
+	w := &GreptimeDBWriter{client: m, assetTable: "asset_state"}
+
+	if err := w.WriteAssets(rows); err != nil {
+		t.Fatalf("WriteAssets: %v", err)
+	}
+	if m.table == nil {
+		t.Fatalf("expected table to be captured")
+	}
+	vals := m.table.GetRows().Rows[0].Values
+	if got := vals[2].GetStringValue(); got != "blue" {
+		t.Fatalf("owner = %s, want blue", got)
+	}
+	if got := vals[9].GetF64Value(); got != 75 {
+		t.Fatalf("health = %f, want 75", got)
+	}
+	if got := vals[10].GetI64Value(); got != 2 {
+		t.Fatalf("attackers = %d, want 2", got)
+	}
+}
+
+func TestGreptimeWriterGeofenceBreaches(t *testing.T) {
+	rows := []telemetry.GeofenceBreachRow{{
+		ClusterID:    "c1",
+		DroneID:      "d1",
+		ZoneID:       "airport",
+		Event:        telemetry.GeofenceExit,
+		PenetrationM: 42,
+		Timestamp:    time.Unix(0, 0).UTC(),
+	}}
+
+	m := &mockGreptimeClient{}
+	w := &GreptimeDBWriter{client: m, geofenceTable: "geofence_breach"}
+
+	if err := w.WriteGeofenceBreaches(rows); err != nil {
+		t.Fatalf("WriteGeofenceBreaches: %v", err)
+	}
+	if m.table == nil {
+		t.Fatalf("expected table to be captured")
+	}
+	vals := m.table.GetRows().Rows[0].Values
+	if got := vals[2].GetStringValue(); got != "airport" {
+		t.Fatalf("zone_id = %s, want airport", got)
+	}
+	if got := vals[3].GetStringValue(); got != telemetry.GeofenceExit {
+		t.Fatalf("event = %s, want exit", got)
+	}
+	if got := vals[4].GetF64Value(); got != 42 {
+		t.Fatalf("penetration_m = %f, want 42", got)
+	}
+}
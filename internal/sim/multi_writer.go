@@ -1,7 +1,10 @@
 package sim
 
 import (
+	"droneops-sim/internal/asset"
+	"droneops-sim/internal/convoy"
 	"droneops-sim/internal/enemy"
+	"droneops-sim/internal/poi"
 	"droneops-sim/internal/telemetry"
 )
 
@@ -73,6 +76,38 @@ func (mw *MultiWriter) WriteDetections(rows []enemy.DetectionRow) error {
 	return nil
 }
 
+// WritePOIDetection sends a POI detection row to all detection writers that support it.
+func (mw *MultiWriter) WritePOIDetection(row poi.DetectionRow) error {
+	for _, w := range mw.detwriters {
+		if pw, ok := w.(POIDetectionWriter); ok {
+			if err := pw.WritePOIDetection(row); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// WritePOIDetections sends multiple POI detections, using batch mode if supported.
+func (mw *MultiWriter) WritePOIDetections(rows []poi.DetectionRow) error {
+	for _, w := range mw.detwriters {
+		if bw, ok := w.(batchPOIDetectionWriter); ok {
+			if err := bw.WritePOIDetections(rows); err != nil {
+				return err
+			}
+			continue
+		}
+		if pw, ok := w.(POIDetectionWriter); ok {
+			for _, r := range rows {
+				if err := pw.WritePOIDetection(r); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
 // WriteSwarmEvent sends a swarm event row to all swarm writers.
 func (mw *MultiWriter) WriteSwarmEvent(row telemetry.SwarmEventRow) error {
 	for _, w := range mw.swarmwriters {
@@ -133,6 +168,146 @@ func (mw *MultiWriter) WriteStates(rows []telemetry.SimulationStateRow) error {
 	return nil
 }
 
+// WritePhase sends a scenario phase row to all telemetry writers that support it.
+func (mw *MultiWriter) WritePhase(row telemetry.ScenarioPhaseRow) error {
+	for _, w := range mw.telewriters {
+		if pw, ok := w.(PhaseWriter); ok {
+			if err := pw.WritePhase(row); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// WritePhases sends multiple scenario phase rows using batch mode if supported.
+func (mw *MultiWriter) WritePhases(rows []telemetry.ScenarioPhaseRow) error {
+	for _, w := range mw.telewriters {
+		if bw, ok := w.(batchPhaseWriter); ok {
+			if err := bw.WritePhases(rows); err != nil {
+				return err
+			}
+			continue
+		}
+		if pw, ok := w.(PhaseWriter); ok {
+			for _, r := range rows {
+				if err := pw.WritePhase(r); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// WriteConvoy sends a convoy state row to all telemetry writers that support it.
+func (mw *MultiWriter) WriteConvoy(row convoy.StateRow) error {
+	for _, w := range mw.telewriters {
+		if cw, ok := w.(ConvoyWriter); ok {
+			if err := cw.WriteConvoy(row); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// WriteConvoys sends multiple convoy state rows using batch mode if supported.
+func (mw *MultiWriter) WriteConvoys(rows []convoy.StateRow) error {
+	for _, w := range mw.telewriters {
+		if bw, ok := w.(batchConvoyWriter); ok {
+			if err := bw.WriteConvoys(rows); err != nil {
+				return err
+			}
+			continue
+		}
+		if cw, ok := w.(ConvoyWriter); ok {
+			for _, r := range rows {
+				if err := cw.WriteConvoy(r); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// WriteAsset sends a fixed asset state row to all telemetry writers that support it.
+func (mw *MultiWriter) WriteAsset(row asset.StateRow) error {
+	for _, w := range mw.telewriters {
+		if aw, ok := w.(AssetWriter); ok {
+			if err := aw.WriteAsset(row); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// WriteAssets sends multiple fixed asset state rows using batch mode if supported.
+func (mw *MultiWriter) WriteAssets(rows []asset.StateRow) error {
+	for _, w := range mw.telewriters {
+		if bw, ok := w.(batchAssetWriter); ok {
+			if err := bw.WriteAssets(rows); err != nil {
+				return err
+			}
+			continue
+		}
+		if aw, ok := w.(AssetWriter); ok {
+			for _, r := range rows {
+				if err := aw.WriteAsset(r); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// WriteGeofenceBreach sends a geofence breach row to all telemetry writers that support it.
+func (mw *MultiWriter) WriteGeofenceBreach(row telemetry.GeofenceBreachRow) error {
+	for _, w := range mw.telewriters {
+		if gw, ok := w.(GeofenceWriter); ok {
+			if err := gw.WriteGeofenceBreach(row); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// WriteGeofenceBreaches sends multiple geofence breach rows using batch mode if supported.
+func (mw *MultiWriter) WriteGeofenceBreaches(rows []telemetry.GeofenceBreachRow) error {
+	for _, w := range mw.telewriters {
+		if bw, ok := w.(batchGeofenceWriter); ok {
+			if err := bw.WriteGeofenceBreaches(rows); err != nil {
+				return err
+			}
+			continue
+		}
+		if gw, ok := w.(GeofenceWriter); ok {
+			for _, r := range rows {
+				if err := gw.WriteGeofenceBreach(r); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// WriteWeather sends the current weather cells to all telemetry writers that support it.
+func (mw *MultiWriter) WriteWeather(cells []MapWeatherCell) error {
+	for _, w := range mw.telewriters {
+		if ww, ok := w.(WeatherWriter); ok {
+			if err := ww.WriteWeather(cells); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 // WriteMission sends a mission row to all writers that support it.
 func (mw *MultiWriter) WriteMission(row telemetry.MissionRow) error {
 	for _, w := range mw.telewriters {
@@ -174,6 +349,15 @@ func (mw *MultiWriter) SetAdminStatus(active bool) {
 	}
 }
 
+// SetScenarioPhase forwards scenario phase changes to writers that support it.
+func (mw *MultiWriter) SetScenarioPhase(name, phase string) {
+	for _, w := range mw.telewriters {
+		if sw, ok := w.(ScenarioStatusWriter); ok {
+			sw.SetScenarioPhase(name, phase)
+		}
+	}
+}
+
 // SetSpawner forwards enemy spawn callbacks to writers that support it.
 func (mw *MultiWriter) SetSpawner(fn func(enemy.Enemy)) {
 	for _, w := range mw.telewriters {
@@ -0,0 +1,13 @@
+package sim
+
+import "droneops-sim/internal/telemetry"
+
+// PhaseWriter handles scenario phase rows.
+type PhaseWriter interface {
+	WritePhase(telemetry.ScenarioPhaseRow) error
+}
+
+// Optional: writers may support batch mode for scenario phase rows.
+type batchPhaseWriter interface {
+	WritePhases([]telemetry.ScenarioPhaseRow) error
+}
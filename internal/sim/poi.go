@@ -0,0 +1,189 @@
+package sim
+
+import (
+	"fmt"
+	log "log/slog"
+
+	"droneops-sim/internal/geo"
+	"droneops-sim/internal/poi"
+	"droneops-sim/internal/scenario"
+	"droneops-sim/internal/telemetry"
+)
+
+// extractionRadiusM is how close a drone must come to a found point of
+// interest to pick it up, and to its base to deliver it.
+const extractionRadiusM = 50.0
+
+// AddPOI places a point of interest in the simulation. Missing IDs are
+// generated and the status defaults to missing.
+func (s *Simulator) AddPOI(p poi.POI) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.addPOI(p)
+}
+
+func (s *Simulator) addPOI(p poi.POI) {
+	if p.ID == "" {
+		p.ID = fmt.Sprintf("%s-%d", p.Type, len(s.pois))
+	}
+	if p.Status == "" {
+		p.Status = poi.StatusMissing
+	}
+	s.pois = append(s.pois, &p)
+}
+
+// POIs returns a snapshot of all points of interest.
+func (s *Simulator) POIs() []poi.POI {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]poi.POI, len(s.pois))
+	for i, p := range s.pois {
+		out[i] = *p
+	}
+	return out
+}
+
+// processPOIDetections detects points of interest around the drone with the
+// same radius and confidence model as enemy detections, scaled down by each
+// point's difficulty. Detections at or above the follow confidence mark the
+// point found; found points within extractionRadiusM of the drone are picked
+// up and extracted once the drone is back at its base, see deliverPOIs.
+func (s *Simulator) processPOIDetections(drone *telemetry.Drone) []poi.DetectionRow {
+	var rows []poi.DetectionRow
+	for _, p := range s.pois {
+		if p.Status == poi.StatusExtracted || p.CarrierID != "" {
+			continue
+		}
+		dist := geo.Distance(drone.Position.Lat, drone.Position.Lon, p.Position.Lat, p.Position.Lon)
+		if dist > s.detectionRadiusM || !s.inSight(drone.Position, p.Position) {
+			continue
+		}
+		conf := s.detectionConfidence(drone.Position, dist) * (1 - p.Difficulty)
+		switch {
+		case p.Status == poi.StatusFound && dist <= extractionRadiusM && s.carries(drone):
+			s.pickUpPOI(p, drone)
+		case p.Status == poi.StatusMissing && conf > 0 && conf >= s.followConfidence:
+			s.setPOIStatus(p, poi.StatusFound)
+		}
+		rows = append(rows, poi.DetectionRow{
+			ClusterID:  s.clusterID,
+			DroneID:    drone.ID,
+			POIID:      p.ID,
+			POIType:    p.Type,
+			Status:     p.Status,
+			Lat:        p.Position.Lat,
+			Lon:        p.Position.Lon,
+			Alt:        p.Position.Alt,
+			DroneLat:   drone.Position.Lat,
+			DroneLon:   drone.Position.Lon,
+			DroneAlt:   drone.Position.Alt,
+			DistanceM:  dist,
+			BearingDeg: geo.Bearing(drone.Position.Lat, drone.Position.Lon, p.Position.Lat, p.Position.Lon),
+			Confidence: conf,
+			Timestamp:  s.now().UTC(),
+		})
+	}
+	return rows
+}
+
+// setPOIStatus advances a point of interest and publishes the matching
+// survivor event. It reports false when the status did not change.
+func (s *Simulator) setPOIStatus(p *poi.POI, st poi.Status) bool {
+	if p.Status == st || p.Status == poi.StatusExtracted {
+		return false
+	}
+	p.Status = st
+	s.logObserverEvent("poi_"+string(st), "id="+p.ID+" type="+string(p.Type))
+	if p.Type != poi.Survivor {
+		return true
+	}
+	switch st {
+	case poi.StatusFound:
+		s.publish(scenario.EventSurvivorFound, p.ID)
+	case poi.StatusExtracted:
+		s.publish(scenario.EventSurvivorExtracted, p.ID)
+	}
+	return true
+}
+
+// carries reports whether the drone can pick up a point of interest: it is
+// flying and not carrying one already.
+func (s *Simulator) carries(drone *telemetry.Drone) bool {
+	if drone.Crashed || drone.Status == telemetry.StatusFailure {
+		return false
+	}
+	for _, p := range s.pois {
+		if p.CarrierID == drone.ID && p.Status != poi.StatusExtracted {
+			return false
+		}
+	}
+	return true
+}
+
+// pickUpPOI loads a found point of interest onto the drone and sends the
+// drone home, releasing any enemy it followed.
+func (s *Simulator) pickUpPOI(p *poi.POI, drone *telemetry.Drone) {
+	p.CarrierID = drone.ID
+	s.removeAssignment(drone)
+	if drone.Phase == telemetry.PhaseTransit || drone.Phase == telemetry.PhaseOnStation {
+		drone.Phase = telemetry.PhaseReturnToBase
+	}
+	s.logObserverEvent("poi_picked_up", "id="+p.ID+" drone="+drone.ID)
+}
+
+// deliverPOIs moves the points of interest being carried with their
+// carrier and extracts those whose carrier landed at its base, or for drones
+// without a flight lifecycle came within extractionRadiusM of it. Points
+// whose carrier failed drop to the ground there and stay found for another
+// drone to pick up.
+func (s *Simulator) deliverPOIs() {
+	for _, p := range s.pois {
+		if p.CarrierID == "" || p.Status == poi.StatusExtracted {
+			continue
+		}
+		d := s.droneIndex[p.CarrierID]
+		if d != nil {
+			p.Position = d.Position
+		}
+		switch {
+		case d == nil || d.Crashed || d.Status == telemetry.StatusFailure:
+			p.CarrierID = ""
+			p.Position.Alt = s.elevation(p.Position.Lat, p.Position.Lon)
+		case d.Phase != telemetry.PhaseIdle && d.Phase != "":
+			// Still on the way home
+		case geo.Distance(d.Position.Lat, d.Position.Lon, d.Base.Lat, d.Base.Lon) <= extractionRadiusM:
+			s.setPOIStatus(p, poi.StatusExtracted)
+		}
+	}
+}
+
+func (s *Simulator) findPOI(id string) *poi.POI {
+	for _, p := range s.pois {
+		if p.ID == id {
+			return p
+		}
+	}
+	return nil
+}
+
+// writePOIDetections emits POI detection rows when the detection writer supports them.
+func (s *Simulator) writePOIDetections(rows []poi.DetectionRow) {
+	if len(rows) == 0 || s.detectionWriter == nil {
+		return
+	}
+	pw, ok := s.detectionWriter.(POIDetectionWriter)
+	if !ok {
+		return
+	}
+	if bw, ok := s.detectionWriter.(batchPOIDetectionWriter); ok {
+		if err := bw.WritePOIDetections(rows); err != nil {
+			log.Error("poi detection batch write failed", "err", err)
+		}
+		return
+	}
+	for _, r := range rows {
+		if err := pw.WritePOIDetection(r); err != nil {
+			log.Error("poi detection write failed", "poi_id", r.POIID, "err", err)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package sim
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+
+	"droneops-sim/internal/config"
+	"droneops-sim/internal/poi"
+	"droneops-sim/internal/scenario"
+	"droneops-sim/internal/telemetry"
+)
+
+// poiDetectionWriter records POI detections alongside enemy detections.
+type poiDetectionWriter struct {
+	MockDetectionWriter
+	pois []poi.DetectionRow
+}
+
+func (w *poiDetectionWriter) WritePOIDetection(r poi.DetectionRow) error {
+	w.pois = append(w.pois, r)
+	return nil
+}
+
+func TestPOIFoundAndExtracted(t *testing.T) {
+	cfg := &config.SimulationConfig{
+		Zones:            []config.Region{{Name: "z", CenterLat: 0, CenterLon: 0, RadiusKM: 1}},
+		Fleets:           []config.Fleet{{Name: "f", Model: "small-fpv", Count: 1, HomeRegion: "z"}},
+		DetectionRadiusM: 1000,
+		FollowConfidence: 50,
+		PointsOfInterest: []config.PointOfInterest{
+			{ID: "pilot", Type: "survivor", Lat: 0.003, Lon: 0},
+			{ID: "crate", Type: "cache", Lat: 0.003, Lon: 0, Difficulty: 0.9},
+		},
+	}
+	dw := &poiDetectionWriter{}
+	sim := NewSimulator("c", cfg, &MockWriter{}, dw, time.Second, rand.New(rand.NewSource(1)), nil)
+	sim.enemyEng.Enemies = nil
+	var events []string
+	sim.Events().Subscribe(func(ev DomainEvent) { events = append(events, ev.Type+":"+ev.SubjectID) })
+	drone := sim.fleets[0].Drones[0]
+
+	// ~330m away: confident enough for the survivor, not for the hidden cache
+	sim.processPOIDetections(drone)
+	pois := sim.POIs()
+	if pois[0].Status != poi.StatusFound || pois[1].Status != poi.StatusMissing {
+		t.Fatalf("unexpected statuses after first pass: %+v", pois)
+	}
+
+	drone.Position = telemetry.Position{Lat: 0.003, Lon: 0.0002, Alt: 100}
+	sim.processPOIDetections(drone)
+	sim.deliverPOIs()
+	if p := sim.POIs()[0]; p.Status != poi.StatusFound || p.CarrierID != drone.ID || drone.Phase != telemetry.PhaseReturnToBase {
+		t.Fatalf("expected survivor picked up and the drone heading home, got %+v phase %s", p, drone.Phase)
+	}
+	if len(events) != 1 {
+		t.Fatalf("expected no extraction before the drone is back, got %v", events)
+	}
+	drone.Position = telemetry.Position{Lat: 0.001, Lon: 0.0001, Alt: 100}
+	sim.deliverPOIs()
+	if p := sim.POIs()[0]; p.Position != drone.Position {
+		t.Fatalf("expected the survivor carried along with the drone, got %+v", p.Position)
+	}
+
+	drone.Phase, drone.Position = telemetry.PhaseIdle, drone.Base
+	sim.deliverPOIs()
+	if p := sim.POIs()[0]; p.Status != poi.StatusExtracted || p.Position != drone.Base {
+		t.Fatalf("expected survivor extracted at the base, got %+v", p)
+	}
+	want := []string{"survivor_found:pilot", "survivor_extracted:pilot"}
+	if len(events) != len(want) || events[0] != want[0] || events[1] != want[1] {
+		t.Fatalf("expected events %v, got %v", want, events)
+	}
+
+	// manual reports for a known POI do not publish twice
+	sim.ReportSurvivorExtracted("pilot")
+	if len(events) != 2 {
+		t.Fatalf("unexpected duplicate event: %v", events)
+	}
+
+	snap := sim.MapSnapshot()
+	if len(snap.POIs) != 2 || snap.POIs[0].Status != poi.StatusExtracted || snap.POIs[1].Type != poi.Cache {
+		t.Fatalf("unexpected map POIs: %+v", snap.POIs)
+	}
+}
+
+func TestTickWritesPOIDetections(t *testing.T) {
+	cfg := &config.SimulationConfig{
+		Zones:            []config.Region{{Name: "z", CenterLat: 0, CenterLon: 0, RadiusKM: 1}},
+		Fleets:           []config.Fleet{{Name: "f", Model: "small-fpv", Count: 1, HomeRegion: "z"}},
+		DetectionRadiusM: 5000,
+		PointsOfInterest: []config.PointOfInterest{{ID: "pilot", Type: "survivor", Lat: 0, Lon: 0}},
+	}
+	dw := &poiDetectionWriter{}
+	sim := NewSimulator("c", cfg, &MockWriter{}, dw, time.Second, rand.New(rand.NewSource(1)), nil)
+	sim.SetScenario(&scenario.Scenario{Name: "sar", Phases: []scenario.Phase{
+		{Name: "search", Triggers: []scenario.Trigger{{Event: "survivor_found", Value: 1, Next: "extract"}}},
+		{Name: "extract"},
+	}})
+	sim.tick(context.Background())
+	if len(dw.pois) == 0 || dw.pois[0].POIID != "pilot" || dw.pois[0].DroneID != "f-0" {
+		t.Fatalf("expected POI detection rows, got %+v", dw.pois)
+	}
+	if st := sim.ScenarioStatus(); st.Phase != "extract" {
+		t.Fatalf("expected detection to advance the scenario, got %s", st.Phase)
+	}
+}
+
+func TestPOIDroppedByFailedCarrier(t *testing.T) {
+	cfg := &config.SimulationConfig{
+		Zones:            []config.Region{{Name: "z", RadiusKM: 1}},
+		Fleets:           []config.Fleet{{Name: "f", Model: "small-fpv", Count: 2, HomeRegion: "z"}},
+		DetectionRadiusM: 1000,
+		PointsOfInterest: []config.PointOfInterest{{ID: "pilot", Type: "survivor", Lat: 0.003}},
+	}
+	sim := NewSimulator("c", cfg, &MockWriter{}, nil, time.Second, rand.New(rand.NewSource(1)), nil)
+	carrier, other := sim.fleets[0].Drones[0], sim.fleets[0].Drones[1]
+	sim.pois[0].Status = poi.StatusFound
+	carrier.Position = telemetry.Position{Lat: 0.003, Alt: 100}
+	sim.processPOIDetections(carrier)
+	if sim.pois[0].CarrierID != carrier.ID {
+		t.Fatalf("expected the survivor picked up, got %+v", sim.pois[0])
+	}
+
+	carrier.Status = telemetry.StatusFailure
+	sim.deliverPOIs()
+	other.Position = telemetry.Position{Lat: 0.003, Alt: 100}
+	sim.processPOIDetections(other)
+	if p := sim.pois[0]; p.Status != poi.StatusFound || p.CarrierID != other.ID {
+		t.Fatalf("expected the survivor picked up again after the carrier failed, got %+v", p)
+	}
+}
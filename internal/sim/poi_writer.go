@@ -0,0 +1,13 @@
+package sim
+
+import "droneops-sim/internal/poi"
+
+// POIDetectionWriter handles point of interest detection events.
+type POIDetectionWriter interface {
+	WritePOIDetection(poi.DetectionRow) error
+}
+
+// Optional: POI detection writers may support batch mode.
+type batchPOIDetectionWriter interface {
+	WritePOIDetections([]poi.DetectionRow) error
+}
@@ -0,0 +1,302 @@
+package sim
+
+import (
+	"fmt"
+	log "log/slog"
+	"time"
+
+	"droneops-sim/internal/config"
+	"droneops-sim/internal/enemy"
+	"droneops-sim/internal/geo"
+	"droneops-sim/internal/scenario"
+	"droneops-sim/internal/telemetry"
+)
+
+const (
+	spawnJitter   = 200.0 // meters, spread of a spawn wave around its origin
+	spawnRadiusKM = 1.0   // region radius of waves spawned at a point
+)
+
+// ScenarioStatus describes the active scenario phase for the admin UI.
+type ScenarioStatus struct {
+	Active      bool      `json:"active"`
+	Name        string    `json:"name,omitempty"`
+	Phase       string    `json:"phase,omitempty"`
+	Description string    `json:"description,omitempty"`
+	EnteredAt   time.Time `json:"entered_at"`
+}
+
+// SetScenario starts driving the simulation from the given scenario's phases.
+func (s *Simulator) SetScenario(sc *scenario.Scenario) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.scenario = scenario.NewRuntime(sc, s.now())
+	s.applyPhaseObjectives()
+	s.spawnPhase()
+	s.writePhases(s.phaseRow(s.scenario.Phase(), telemetry.PhaseTransitionEnter, "", 0))
+	s.logObserverEvent("scenario_start", fmt.Sprintf("scenario=%s phase=%s", sc.Name, s.scenario.Phase()))
+	s.notifyScenarioPhase()
+}
+
+// ScenarioStatus returns the active scenario and phase, if any.
+func (s *Simulator) ScenarioStatus() ScenarioStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.scenario == nil {
+		return ScenarioStatus{}
+	}
+	st := ScenarioStatus{
+		Active:    true,
+		Name:      s.scenario.Scenario().Name,
+		Phase:     s.scenario.Phase(),
+		EnteredAt: s.scenario.Entered().UTC(),
+	}
+	if p, ok := s.scenario.CurrentPhase(); ok {
+		st.Description = p.Description
+	}
+	return st
+}
+
+// handleScenarioEvent feeds domain events into the scenario runtime. It runs
+// while the simulator lock is held by the publisher.
+func (s *Simulator) handleScenarioEvent(ev DomainEvent) {
+	if s.scenario != nil {
+		s.scenario.Record(scenario.Event{Type: ev.Type, Value: ev.Value})
+	}
+}
+
+// advanceScenario evaluates phase triggers and logs any resulting transition.
+func (s *Simulator) advanceScenario() {
+	if s.scenario == nil {
+		return
+	}
+	tr, ok := s.scenario.Advance(s.now(), s.scenarioMetrics())
+	if !ok {
+		return
+	}
+	s.logObserverEvent("phase_change", fmt.Sprintf("from=%s to=%s event=%s value=%d", tr.From, tr.To, tr.Event, tr.Value))
+	s.applyPhaseObjectives()
+	s.spawnPhase()
+	s.writePhases(
+		s.phaseRow(tr.From, telemetry.PhaseTransitionExit, tr.Event, tr.Value),
+		s.phaseRow(tr.To, telemetry.PhaseTransitionEnter, tr.Event, tr.Value),
+	)
+	s.notifyScenarioPhase()
+}
+
+// scenarioMetrics snapshots the simulation state scenario conditions refer to.
+func (s *Simulator) scenarioMetrics() scenario.Metrics {
+	m := scenario.Metrics{}
+	var total, active int
+	var battery float64
+	for _, f := range s.fleets {
+		for _, d := range f.Drones {
+			total++
+			battery += d.Battery
+			m[scenario.MetricDronesPrefix+d.Status]++
+			if d.Status != telemetry.StatusFailure {
+				active++
+			}
+		}
+	}
+	m[scenario.MetricTotalDrones] = float64(total)
+	m[scenario.MetricActiveDrones] = float64(active)
+	if total > 0 {
+		m[scenario.MetricAvgBattery] = battery / float64(total)
+	}
+	if s.enemyEng != nil {
+		for _, en := range s.enemyEng.Enemies {
+			if en.Status != enemy.EnemyActive {
+				continue
+			}
+			m[scenario.MetricEnemyCount]++
+			m[scenario.MetricEnemiesPrefix+string(en.Type)]++
+		}
+	}
+	m[scenario.MetricFollowers] = float64(len(s.droneAssignments))
+	for _, a := range s.assets {
+		m[a.ID+scenario.MetricHealthSuffix] = a.Health()
+	}
+	return m
+}
+
+func (s *Simulator) phaseRow(phase, transition, event string, value int) telemetry.ScenarioPhaseRow {
+	return telemetry.ScenarioPhaseRow{
+		ClusterID:  s.clusterID,
+		Scenario:   s.scenario.Scenario().Name,
+		Phase:      phase,
+		Transition: transition,
+		Event:      event,
+		Value:      value,
+		Timestamp:  s.now().UTC(),
+	}
+}
+
+// writePhases emits scenario phase rows when the writer supports them.
+func (s *Simulator) writePhases(rows ...telemetry.ScenarioPhaseRow) {
+	pw, ok := s.writer.(PhaseWriter)
+	if !ok {
+		return
+	}
+	if bw, ok := s.writer.(batchPhaseWriter); ok {
+		if err := bw.WritePhases(rows); err != nil {
+			log.Error("phase batch write failed", "err", err)
+		}
+		return
+	}
+	for _, r := range rows {
+		if err := pw.WritePhase(r); err != nil {
+			log.Error("phase write failed", "phase", r.Phase, "err", err)
+		}
+	}
+}
+
+// applyPhaseObjectives replaces the enemy objectives with those of the active phase.
+func (s *Simulator) applyPhaseObjectives() {
+	if s.enemyEng == nil {
+		return
+	}
+	s.enemyEng.ClearObjectives()
+	p, ok := s.scenario.CurrentPhase()
+	if !ok {
+		return
+	}
+	for _, eo := range p.EnemyObjectives {
+		obj := enemy.Objective{Action: enemy.Action(eo.Action), Target: eo.Target}
+		for _, pt := range eo.Route {
+			obj.Route = append(obj.Route, telemetry.Position{Lat: pt.Lat, Lon: pt.Lon})
+		}
+		s.enemyEng.SetObjective(eo.ID, obj)
+	}
+}
+
+// spawnPhase adds the enemies and fleets listed in the active phase's spawn
+// block and assigns spawned groups their objectives.
+func (s *Simulator) spawnPhase() {
+	p, ok := s.scenario.CurrentPhase()
+	if !ok {
+		return
+	}
+	for _, es := range p.Spawn.Enemies {
+		group := es.Group
+		if group == "" {
+			group = p.Name
+		}
+		region := s.spawnRegion(es)
+		n := 0
+		for i := 0; i < es.Count; i++ {
+			id := fmt.Sprintf("%s-%d", group, n)
+			for s.enemyObjects[id] != nil {
+				n++
+				id = fmt.Sprintf("%s-%d", group, n)
+			}
+			n++
+			s.spawnEnemy(enemy.Enemy{
+				ID:         id,
+				Type:       enemy.EnemyType(es.Type),
+				Position:   s.jitter(region.CenterLat, region.CenterLon),
+				Confidence: 100,
+				Region:     region,
+				Group:      group,
+			})
+		}
+		if es.Objective != nil && s.enemyEng != nil {
+			obj := enemy.Objective{Action: enemy.Action(es.Objective.Action), Target: es.Objective.Target}
+			for _, pt := range es.Objective.Route {
+				obj.Route = append(obj.Route, telemetry.Position{Lat: pt.Lat, Lon: pt.Lon})
+			}
+			s.enemyEng.SetObjective(group, obj)
+		}
+		s.logObserverEvent("spawn_enemies", fmt.Sprintf("group=%s type=%s count=%d", group, es.Type, es.Count))
+	}
+	for _, fs := range p.Spawn.Fleets {
+		name := fs.Name
+		if name == "" {
+			name = fs.Model
+		}
+		pattern := fs.Pattern
+		if pattern == "" {
+			pattern = "patrol"
+		}
+		s.launchFleet(config.Fleet{Name: name, Model: fs.Model, Count: fs.Count, MovementPattern: pattern, HomeRegion: fs.Region, MissionID: fs.Mission})
+		s.logObserverEvent("launch_fleet", fmt.Sprintf("fleet=%s model=%s count=%d", name, fs.Model, fs.Count))
+	}
+}
+
+// spawnRegion resolves where a spawn wave appears: around its point, or the
+// zone or mission named by its region (default the first zone), shifted by
+// the optional bearing and distance.
+func (s *Simulator) spawnRegion(es scenario.EnemySpawn) telemetry.Region {
+	var region telemetry.Region
+	switch {
+	case es.Point != nil:
+		region = telemetry.Region{CenterLat: es.Point.Lat, CenterLon: es.Point.Lon, RadiusKM: spawnRadiusKM}
+	default:
+		z := s.cfg.Zones[0]
+		for _, c := range s.cfg.Zones {
+			if c.Name == es.Region {
+				z = c
+				break
+			}
+		}
+		for _, m := range s.cfg.Missions {
+			if m.ID == es.Region {
+				z = m.Region
+				break
+			}
+		}
+		region = z.Telemetry()
+	}
+	if es.DistanceKM > 0 {
+		region.CenterLat, region.CenterLon = geo.Destination(region.CenterLat, region.CenterLon, es.Bearing, es.DistanceKM*1000)
+		region.Shape = nil // The shifted wave roams the bounding circle
+	}
+	return region
+}
+
+// jitter returns a random point of the spawnJitter square around lat, lon.
+func (s *Simulator) jitter(lat, lon float64) telemetry.Position {
+	north := s.rand.Float64()*spawnJitter - spawnJitter/2
+	east := s.rand.Float64()*spawnJitter - spawnJitter/2
+	var pos telemetry.Position
+	pos.Lat, pos.Lon = geo.Offset(lat, lon, north, east)
+	return pos
+}
+
+// updateEnemyTargets publishes the positions enemy objectives can aim at:
+// assets, convoys, fleets (centroid of their drones), missions and zones by name.
+func (s *Simulator) updateEnemyTargets() {
+	if s.enemyEng == nil {
+		return
+	}
+	for _, z := range s.cfg.Zones {
+		s.enemyEng.SetTarget(z.Name, telemetry.Position{Lat: z.CenterLat, Lon: z.CenterLon})
+	}
+	for _, m := range s.cfg.Missions {
+		s.enemyEng.SetTarget(m.ID, telemetry.Position{Lat: m.Region.CenterLat, Lon: m.Region.CenterLon})
+	}
+	for _, f := range s.fleets {
+		if len(f.Drones) == 0 {
+			continue
+		}
+		var c telemetry.Position
+		for _, d := range f.Drones {
+			c.Lat += d.Position.Lat
+			c.Lon += d.Position.Lon
+		}
+		n := float64(len(f.Drones))
+		s.enemyEng.SetTarget(f.Name, telemetry.Position{Lat: c.Lat / n, Lon: c.Lon / n})
+	}
+	for _, c := range s.convoys {
+		s.enemyEng.SetTarget(c.ID, c.Position)
+	}
+	for _, a := range s.assets {
+		s.enemyEng.SetTarget(a.ID, a.Position)
+	}
+}
+
+func (s *Simulator) notifyScenarioPhase() {
+	if sw, ok := s.writer.(ScenarioStatusWriter); ok {
+		sw.SetScenarioPhase(s.scenario.Scenario().Name, s.scenario.Phase())
+	}
+}
@@ -0,0 +1,6 @@
+package sim
+
+// ScenarioStatusWriter allows writers to receive scenario phase updates.
+type ScenarioStatusWriter interface {
+	SetScenarioPhase(name, phase string)
+}
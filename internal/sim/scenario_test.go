@@ -0,0 +1,238 @@
+package sim
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+
+	"droneops-sim/internal/config"
+	"droneops-sim/internal/enemy"
+	"droneops-sim/internal/geo"
+	"droneops-sim/internal/scenario"
+	"droneops-sim/internal/telemetry"
+)
+
+// scenarioWriter records scenario phase notifications and rows.
+type scenarioWriter struct {
+	MockWriter
+	phases []string
+	rows   []telemetry.ScenarioPhaseRow
+}
+
+func (w *scenarioWriter) WritePhase(r telemetry.ScenarioPhaseRow) error {
+	w.rows = append(w.rows, r)
+	return nil
+}
+
+func (w *scenarioWriter) SetScenarioPhase(name, phase string) {
+	w.phases = append(w.phases, name+"/"+phase)
+}
+
+func TestSimulatorScenarioPhases(t *testing.T) {
+	cfg := &config.SimulationConfig{
+		Zones:  []config.Region{{Name: "z", CenterLat: 0, CenterLon: 0, RadiusKM: 1}},
+		Fleets: []config.Fleet{{Name: "f", Model: "small-fpv", Count: 1, HomeRegion: "z"}},
+	}
+	now := time.Unix(0, 0).UTC()
+	w := &scenarioWriter{}
+	sim := NewSimulator("c", cfg, w, nil, time.Second, rand.New(rand.NewSource(1)), func() time.Time { return now })
+	sim.SetScenario(&scenario.Scenario{Name: "test", Phases: []scenario.Phase{
+		{Name: "setup", Triggers: []scenario.Trigger{{Event: "time_elapsed", Value: 5, Next: "fight"}}},
+		{Name: "fight", Triggers: []scenario.Trigger{{Event: "enemy_destroyed", Value: 1, Next: "done"}}},
+		{Name: "done"},
+	}})
+
+	sim.tick(context.Background())
+	if st := sim.ScenarioStatus(); st.Phase != "setup" {
+		t.Fatalf("expected setup phase, got %+v", st)
+	}
+
+	now = now.Add(5 * time.Second)
+	sim.tick(context.Background())
+	if st := sim.ScenarioStatus(); st.Phase != "fight" {
+		t.Fatalf("expected fight phase, got %+v", st)
+	}
+
+	sim.SpawnEnemy(enemy.Enemy{ID: "e1", Type: enemy.EnemyVehicle, Position: telemetry.Position{Lat: 0, Lon: 0}, Status: enemy.EnemyActive})
+	sim.UpdateEnemyStatus("e1", enemy.EnemyNeutralized)
+	sim.tick(context.Background())
+	if st := sim.ScenarioStatus(); st.Phase != "done" {
+		t.Fatalf("expected done phase, got %+v", st)
+	}
+
+	want := []string{"test/setup", "test/fight", "test/done"}
+	if len(w.phases) != len(want) {
+		t.Fatalf("expected phase notifications %v, got %v", want, w.phases)
+	}
+	for i := range want {
+		if w.phases[i] != want[i] {
+			t.Fatalf("expected phase notifications %v, got %v", want, w.phases)
+		}
+	}
+	wantRows := []string{"setup/enter", "setup/exit", "fight/enter", "fight/exit", "done/enter"}
+	if len(w.rows) != len(wantRows) {
+		t.Fatalf("expected phase rows %v, got %+v", wantRows, w.rows)
+	}
+	for i, r := range w.rows {
+		if r.Phase+"/"+r.Transition != wantRows[i] || r.Scenario != "test" || r.ClusterID != "c" {
+			t.Fatalf("row %d: expected %s, got %+v", i, wantRows[i], r)
+		}
+	}
+	if w.rows[2].Event != "time_elapsed" || w.rows[2].Value != 5 {
+		t.Fatalf("expected triggering event on phase entry, got %+v", w.rows[2])
+	}
+
+	var changes int
+	for _, ev := range sim.ObserverEvents() {
+		if ev.Type == "phase_change" {
+			changes++
+		}
+	}
+	if changes != 2 {
+		t.Fatalf("expected 2 phase_change observer events, got %d", changes)
+	}
+}
+
+func TestSimulatorAppliesPhaseObjectives(t *testing.T) {
+	cfg := &config.SimulationConfig{
+		Zones:    []config.Region{{Name: "z", CenterLat: 0, CenterLon: 0, RadiusKM: 1}},
+		Missions: []config.Mission{{ID: "outpost", Region: config.Region{Name: "outpost", CenterLat: 0.05, CenterLon: 0}}},
+		Fleets:   []config.Fleet{{Name: "f", Model: "small-fpv", Count: 1, HomeRegion: "z"}},
+	}
+	now := time.Unix(0, 0).UTC()
+	sim := NewSimulator("c", cfg, &MockWriter{}, nil, time.Second, rand.New(rand.NewSource(1)), func() time.Time { return now })
+	sim.SetScenario(&scenario.Scenario{Name: "raid", Phases: []scenario.Phase{
+		{Name: "wait", Triggers: []scenario.Trigger{{Event: "time_elapsed", Value: 1, Next: "raid"}}},
+		{Name: "raid", EnemyObjectives: []scenario.EnemyObjective{{ID: "z", Action: "attack", Target: "outpost"}}},
+	}})
+	now = now.Add(time.Second)
+	sim.tick(context.Background())
+	if st := sim.ScenarioStatus(); st.Phase != "raid" {
+		t.Fatalf("expected raid phase, got %s", st.Phase)
+	}
+
+	target := telemetry.Position{Lat: 0.05}
+	before := make(map[string]float64)
+	for _, en := range sim.enemyEng.Enemies {
+		if _, ok := sim.enemyEng.ObjectiveFor(en); !ok {
+			t.Fatalf("expected enemy %s in group %q to receive objective", en.ID, en.Group)
+		}
+		before[en.ID] = geo.Distance(en.Position.Lat, en.Position.Lon, target.Lat, target.Lon)
+	}
+	sim.tick(context.Background())
+	for _, en := range sim.enemyEng.Enemies {
+		if after := geo.Distance(en.Position.Lat, en.Position.Lon, target.Lat, target.Lon); after >= before[en.ID] {
+			t.Fatalf("expected enemy %s to close on outpost", en.ID)
+		}
+	}
+}
+
+func TestSimulatorScenarioMetrics(t *testing.T) {
+	cfg := &config.SimulationConfig{
+		Zones:  []config.Region{{Name: "z", CenterLat: 0, CenterLon: 0, RadiusKM: 1}},
+		Fleets: []config.Fleet{{Name: "f", Model: "small-fpv", Count: 2, HomeRegion: "z"}},
+	}
+	now := time.Unix(0, 0).UTC()
+	sim := NewSimulator("c", cfg, &MockWriter{}, nil, time.Second, rand.New(rand.NewSource(1)), func() time.Time { return now })
+	sim.enemyEng.Enemies = nil
+	sim.fleets[0].Drones[0].Battery, sim.fleets[0].Drones[0].Status = 10, telemetry.StatusLowBattery
+	sim.fleets[0].Drones[1].Battery, sim.fleets[0].Drones[1].Status = 30, telemetry.StatusFailure
+	sim.SpawnEnemy(enemy.Enemy{ID: "e1", Type: enemy.EnemyDrone, Status: enemy.EnemyActive})
+
+	m := sim.scenarioMetrics()
+	want := scenario.Metrics{
+		"total_drones": 2, "active_drones": 1, "avg_battery": 20,
+		"drones_low_battery": 1, "drones_failed": 1,
+		"enemy_count": 1, "enemies_drone": 1, "followers": 0,
+	}
+	for k, v := range want {
+		if m[k] != v {
+			t.Fatalf("metric %s: expected %v, got %v (all: %v)", k, v, m[k], m)
+		}
+	}
+
+	sim.SetScenario(&scenario.Scenario{Name: "hold", Phases: []scenario.Phase{
+		{Name: "defend", Triggers: []scenario.Trigger{{
+			Any:  []scenario.Condition{{Metric: "avg_battery", Op: "<", Value: 30}, {Metric: "enemy_count", Op: "==", Value: 0}},
+			Next: "withdraw",
+		}}},
+		{Name: "withdraw"},
+	}})
+	sim.advanceScenario()
+	if st := sim.ScenarioStatus(); st.Phase != "withdraw" {
+		t.Fatalf("expected withdraw phase, got %s", st.Phase)
+	}
+}
+
+func TestSimulatorPhaseSpawns(t *testing.T) {
+	cfg := &config.SimulationConfig{
+		Zones:  []config.Region{{Name: "z", CenterLat: 0, CenterLon: 0, RadiusKM: 1}},
+		Fleets: []config.Fleet{{Name: "f", Model: "small-fpv", Count: 1, HomeRegion: "z"}},
+	}
+	now := time.Unix(0, 0).UTC()
+	sim := NewSimulator("c", cfg, &MockWriter{}, nil, time.Second, rand.New(rand.NewSource(1)), func() time.Time { return now })
+	sim.enemyEng.Enemies = nil
+	sim.SetScenario(&scenario.Scenario{Name: "waves", Phases: []scenario.Phase{
+		{Name: "wait", Triggers: []scenario.Trigger{{Event: "time_elapsed", Value: 1, Next: "wave"}}},
+		{Name: "wave", Spawn: scenario.Spawn{
+			Enemies: []scenario.EnemySpawn{{
+				Type: "drone", Count: 3, Point: &scenario.Point{Lat: 0.1, Lon: 0},
+				Objective: &scenario.SpawnObjective{Action: "attack", Target: "f"},
+			}},
+			Fleets: []scenario.FleetSpawn{{Name: "f", Model: "small-fpv", Count: 2}},
+		}, Triggers: []scenario.Trigger{{Event: "time_elapsed", Value: 1, Next: "wait"}}},
+	}})
+	if len(sim.enemyEng.Enemies) != 0 {
+		t.Fatalf("expected no spawns in the first phase")
+	}
+
+	for i := 0; i < 3; i++ {
+		now = now.Add(time.Second)
+		sim.tick(context.Background())
+	}
+	if st := sim.ScenarioStatus(); st.Phase != "wave" {
+		t.Fatalf("expected wave phase, got %s", st.Phase)
+	}
+	if len(sim.enemyEng.Enemies) != 6 {
+		t.Fatalf("expected two waves of 3 enemies, got %d", len(sim.enemyEng.Enemies))
+	}
+	ids := make(map[string]bool)
+	for _, en := range sim.enemyEng.Enemies {
+		if ids[en.ID] {
+			t.Fatalf("duplicate enemy ID %s", en.ID)
+		}
+		ids[en.ID] = true
+		if en.Group != "wave" || en.Type != enemy.EnemyDrone {
+			t.Fatalf("unexpected spawned enemy %+v", en)
+		}
+		if obj, ok := sim.enemyEng.ObjectiveFor(en); !ok || obj.Target != "f" {
+			t.Fatalf("expected spawned enemy to attack fleet, got %+v", obj)
+		}
+	}
+
+	if len(sim.fleets) != 1 || len(sim.fleets[0].Drones) != 5 {
+		t.Fatalf("expected reinforcements to join fleet f, got %+v", sim.fleets)
+	}
+	for _, d := range sim.fleets[0].Drones {
+		if sim.droneIndex[d.ID] != d || sim.droneFleet[d.ID] != &sim.fleets[0] {
+			t.Fatalf("drone %s not indexed", d.ID)
+		}
+		if d.ID != "f-0" && d.MovementPattern != "patrol" {
+			t.Fatalf("expected default patrol pattern, got %q", d.MovementPattern)
+		}
+	}
+}
+
+func TestLaunchSwarmUniqueIDs(t *testing.T) {
+	cfg := &config.SimulationConfig{Zones: []config.Region{{Name: "z", RadiusKM: 1}}}
+	sim := NewSimulator("c", cfg, &MockWriter{}, nil, time.Second, rand.New(rand.NewSource(1)), nil)
+	sim.LaunchSwarm("small-fpv", 2)
+	sim.LaunchSwarm("small-fpv", 2)
+	if len(sim.fleets) != 1 || len(sim.fleets[0].Drones) != 4 {
+		t.Fatalf("expected one fleet of 4 drones, got %+v", sim.fleets)
+	}
+	if len(sim.droneIndex) != 4 {
+		t.Fatalf("expected 4 unique drone IDs, got %d", len(sim.droneIndex))
+	}
+}
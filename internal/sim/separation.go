@@ -0,0 +1,83 @@
+package sim
+
+import (
+	"droneops-sim/internal/geo"
+	"droneops-sim/internal/telemetry"
+)
+
+// defaultCollisionRadiusM is the distance below which two drones collide when
+// the configuration sets no radius.
+const defaultCollisionRadiusM = 2.0
+
+// separate nudges a drone that just moved away from the drones within its
+// minimum separation.
+func (s *Simulator) separate(drone *telemetry.Drone) {
+	var others []*telemetry.Drone
+	for i := range s.fleets {
+		others = append(others, s.fleets[i].Drones...)
+	}
+	telemetry.Separate(drone, others)
+}
+
+// checkSeparation emits a near_miss event for airborne drones closer than
+// their separation and a collision event for drones within the collision
+// radius. A pair is reported once per encounter, and again when a near miss
+// turns into a collision. Collisions fail both drones when configured.
+func (s *Simulator) checkSeparation() {
+	radius := s.cfg.Collisions.RadiusM
+	if radius <= 0 {
+		radius = defaultCollisionRadiusM
+	}
+	var drones []*telemetry.Drone
+	for i := range s.fleets {
+		for _, d := range s.fleets[i].Drones {
+			if d.Airborne() {
+				drones = append(drones, d)
+			}
+		}
+	}
+	conflicts := make(map[string]string)
+	for i, a := range drones {
+		for _, b := range drones[i+1:] {
+			dist := telemetry.Distance(a, b)
+			event := telemetry.SwarmEventNearMiss
+			if dist < radius {
+				event = telemetry.SwarmEventCollision
+			} else if dist >= telemetry.Separation(a, b) {
+				continue
+			}
+			key := a.ID + "|" + b.ID
+			conflicts[key] = event
+			if prev := s.conflicts[key]; prev == event || prev == telemetry.SwarmEventCollision {
+				continue
+			}
+			s.writeSwarmEvent(telemetry.SwarmEventRow{
+				ClusterID: s.clusterID,
+				EventType: event,
+				DroneIDs:  []string{a.ID, b.ID},
+				DistanceM: dist,
+				Timestamp: s.now().UTC(),
+			})
+			if event == telemetry.SwarmEventCollision && s.cfg.Collisions.FailDrones {
+				s.crash(a)
+				s.crash(b)
+			}
+		}
+	}
+	s.conflicts = conflicts
+}
+
+// crash fails a drone destroyed in a collision and releases its enemy.
+func (s *Simulator) crash(drone *telemetry.Drone) {
+	drone.Crashed = true
+	drone.Status = telemetry.StatusFailure
+	s.removeAssignment(drone)
+}
+
+// padPosition spreads the drones of a fleet over a grid of pads around pos,
+// sep meters apart, so they do not start on top of each other.
+func padPosition(pos telemetry.Position, i, n int, sep float64) telemetry.Position {
+	north, east := formationOffset(FormationBox, i, n, sep)
+	lat, lon := geo.Offset(pos.Lat, pos.Lon, north, east)
+	return telemetry.Position{Lat: lat, Lon: lon, Alt: pos.Alt}
+}
@@ -0,0 +1,61 @@
+package sim
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"droneops-sim/internal/config"
+	"droneops-sim/internal/telemetry"
+)
+
+func TestDronesStartOnOwnPads(t *testing.T) {
+	cfg := &config.SimulationConfig{
+		Zones:  []config.Region{{Name: "z", CenterLat: 48.2, CenterLon: 16.4, RadiusKM: 1}},
+		Fleets: []config.Fleet{{Name: "f", Model: "medium-uav", Count: 4, MovementPattern: "loiter", HomeRegion: "z"}},
+	}
+	sim := NewSimulator("c", cfg, &mockSwarmWriter{}, nil, time.Second, rand.New(rand.NewSource(1)), nil)
+	drones := sim.fleets[0].Drones
+	for i, a := range drones {
+		for _, b := range drones[i+1:] {
+			if d := telemetry.Distance(a, b); d < telemetry.Separation(a, b)-0.01 {
+				t.Fatalf("expected %s and %s to start separated, got %.2f m", a.ID, b.ID, d)
+			}
+		}
+	}
+}
+
+func TestSeparationEventsAndCollisionFailure(t *testing.T) {
+	cfg := &config.SimulationConfig{
+		Zones:      []config.Region{{Name: "z", CenterLat: 48.2, CenterLon: 16.4, RadiusKM: 1}},
+		Fleets:     []config.Fleet{{Name: "f", Model: "medium-uav", Count: 3, MovementPattern: "loiter", HomeRegion: "z"}},
+		Collisions: config.Collisions{RadiusM: 3, FailDrones: true},
+	}
+	w := &mockSwarmWriter{}
+	sim := NewSimulator("c", cfg, w, nil, time.Second, rand.New(rand.NewSource(1)), nil)
+	drones := sim.fleets[0].Drones
+	drones[2].Position.Alt += 1000
+	drones[1].Position = drones[0].Position
+	drones[1].Position.Lat += 10.0 / 111000
+
+	sim.checkSeparation()
+	sim.checkSeparation()
+	if len(w.events) != 1 || w.events[0].EventType != telemetry.SwarmEventNearMiss || w.events[0].DistanceM < 9.9 || w.events[0].DistanceM > 10.1 {
+		t.Fatalf("expected one near_miss at 10 m, got %+v", w.events)
+	}
+	if drones[0].Crashed || drones[1].Crashed {
+		t.Fatal("expected near miss not to fail drones")
+	}
+
+	drones[1].Position = drones[0].Position
+	sim.checkSeparation()
+	if len(w.events) != 2 || w.events[1].EventType != telemetry.SwarmEventCollision {
+		t.Fatalf("expected near miss to escalate to collision, got %+v", w.events)
+	}
+	if ids := w.events[1].DroneIDs; len(ids) != 2 || ids[0] != drones[0].ID || ids[1] != drones[1].ID {
+		t.Fatalf("expected both drone IDs, got %v", ids)
+	}
+	if !drones[0].Crashed || !drones[1].Crashed || drones[0].Status != telemetry.StatusFailure || drones[2].Crashed {
+		t.Fatal("expected collision to fail both drones only")
+	}
+}
@@ -12,13 +12,21 @@ import (
 
 	"github.com/google/uuid"
 
+	"droneops-sim/internal/asset"
 	"droneops-sim/internal/config"
+	"droneops-sim/internal/convoy"
+	"droneops-sim/internal/coverage"
 	"droneops-sim/internal/enemy"
+	"droneops-sim/internal/geo"
+	"droneops-sim/internal/poi"
+	"droneops-sim/internal/scenario"
 	"droneops-sim/internal/telemetry"
+	"droneops-sim/internal/terrain"
+	"droneops-sim/internal/weather"
 )
 
 const (
-	sensorErrorMaxOffset   = 0.005 // degrees (~500m)
+	sensorErrorMaxOffset   = 500.0 // meters
 	interceptLateralMeters = 50.0  // lateral spacing between intercept points
 )
 
@@ -39,6 +47,7 @@ type MapDrone struct {
 	Lon       float64  `json:"lon"`
 	Alt       float64  `json:"alt"`
 	Battery   float64  `json:"battery"`
+	Phase     string   `json:"phase,omitempty"`
 	FollowLat *float64 `json:"follow_lat,omitempty"`
 	FollowLon *float64 `json:"follow_lon,omitempty"`
 	FollowAlt *float64 `json:"follow_alt,omitempty"`
@@ -54,19 +63,99 @@ type MapEnemy struct {
 }
 
 // MapMission represents a mission region for annotations on the map.
+// Polygon regions carry their GeoJSON MultiPolygon coordinates.
 type MapMission struct {
-	ID       string  `json:"id"`
-	Name     string  `json:"name"`
-	Lat      float64 `json:"lat"`
-	Lon      float64 `json:"lon"`
-	RadiusKM float64 `json:"radius_km"`
+	ID       string           `json:"id"`
+	Name     string           `json:"name"`
+	Lat      float64          `json:"lat"`
+	Lon      float64          `json:"lon"`
+	RadiusKM float64          `json:"radius_km"`
+	Polygons [][][][2]float64 `json:"polygons,omitempty"`
 }
 
-// MapData aggregates drone, enemy, and mission positions for the map view.
+// MapZone represents an operational zone on the map.
+type MapZone struct {
+	Name     string           `json:"name"`
+	Lat      float64          `json:"lat"`
+	Lon      float64          `json:"lon"`
+	RadiusKM float64          `json:"radius_km"`
+	Polygons [][][][2]float64 `json:"polygons,omitempty"`
+}
+
+// MapRestrictedArea represents a no-fly zone on the map, labeled at the
+// center of its bounding box.
+type MapRestrictedArea struct {
+	ID       string           `json:"id"`
+	Lat      float64          `json:"lat"`
+	Lon      float64          `json:"lon"`
+	FloorM   float64          `json:"floor_m"`
+	CeilingM float64          `json:"ceiling_m"`
+	Active   bool             `json:"active"`
+	Polygons [][][][2]float64 `json:"polygons"`
+}
+
+// MapPOI represents a point of interest for the 3D map.
+type MapPOI struct {
+	ID     string     `json:"id"`
+	Type   poi.Type   `json:"type"`
+	Status poi.Status `json:"status"`
+	Lat    float64    `json:"lat"`
+	Lon    float64    `json:"lon"`
+	Alt    float64    `json:"alt"`
+}
+
+// MapConvoy represents a friendly convoy and its remaining route for the 3D map.
+type MapConvoy struct {
+	ID     string        `json:"id"`
+	Status convoy.Status `json:"status"`
+	Health float64       `json:"health"`
+	Lat    float64       `json:"lat"`
+	Lon    float64       `json:"lon"`
+	Alt    float64       `json:"alt"`
+	Route  []MapPoint    `json:"route,omitempty"`
+}
+
+// MapAsset represents a fixed asset and its attack radius for the 3D map.
+type MapAsset struct {
+	ID      string       `json:"id"`
+	Owner   string       `json:"owner,omitempty"`
+	Status  asset.Status `json:"status"`
+	Health  float64      `json:"health"`
+	Lat     float64      `json:"lat"`
+	Lon     float64      `json:"lon"`
+	Alt     float64      `json:"alt"`
+	RadiusM float64      `json:"radius_m"`
+}
+
+// MapPoint is a route point on the map.
+type MapPoint struct {
+	Lat float64 `json:"lat"`
+	Lon float64 `json:"lon"`
+}
+
+// MapWeatherCell represents a drifting weather cell for the map views.
+type MapWeatherCell struct {
+	ID              string       `json:"id"`
+	Type            weather.Type `json:"type"`
+	Lat             float64      `json:"lat"`
+	Lon             float64      `json:"lon"`
+	RadiusM         float64      `json:"radius_m"`
+	Intensity       float64      `json:"intensity"`
+	DriftSpeedMPS   float64      `json:"drift_speed_mps"`
+	DriftHeadingDeg float64      `json:"drift_heading_deg"`
+}
+
+// MapData aggregates drone, enemy, point of interest, convoy, asset, weather, mission and restricted area positions for the map view.
 type MapData struct {
-	Drones   []MapDrone   `json:"drones"`
-	Enemies  []MapEnemy   `json:"enemies"`
-	Missions []MapMission `json:"missions"`
+	Drones          []MapDrone          `json:"drones"`
+	Enemies         []MapEnemy          `json:"enemies"`
+	POIs            []MapPOI            `json:"pois"`
+	Convoys         []MapConvoy         `json:"convoys"`
+	Assets          []MapAsset          `json:"assets"`
+	Weather         []MapWeatherCell    `json:"weather"`
+	Missions        []MapMission        `json:"missions"`
+	Zones           []MapZone           `json:"zones"`
+	RestrictedAreas []MapRestrictedArea `json:"restricted_areas"`
 }
 
 // ObserverEvent represents a mission event used by analyst tools.
@@ -91,6 +180,7 @@ type Simulator struct {
 	detectionRadiusM      float64
 	sensorNoise           float64
 	terrainOcclusion      float64
+	terrain               *terrain.Grid // ground elevation model, nil without one
 	weatherImpact         float64
 	swarmResponses        map[string]int
 	missionCriticality    int
@@ -109,19 +199,42 @@ type Simulator struct {
 	enemyObjects          map[string]*enemy.Enemy
 	droneIndex            map[string]*telemetry.Drone
 	droneFleet            map[string]*DroneFleet
+	pois                  []*poi.POI
+	convoys               []*convoy.Convoy
+	assets                []*asset.Asset
+	bases                 []*baseStation
+	weatherCells          []*weather.Cell
+	coverage              []*coverage.Grid
+	windGust              float64
+	conflicts             map[string]string  // event of drone pairs within separation, keyed by "a|b"
+	breaches              map[string]float64 // deepest penetration of drones inside restricted airspace, keyed by "drone|zone"
+	started               time.Time
 	observerEvents        []ObserverEvent
 	observerIdx           int
 	observerPerspective   string
+	scenario              *scenario.Runtime
+	events                *EventBus
 	mu                    sync.Mutex
 	rand                  *rand.Rand
 	now                   func() time.Time
 }
 
-// DroneFleet holds runtime drones for one fleet.
+// DroneFleet holds runtime drones for one fleet. Escort names the convoy
+// escorted by drones with the escort movement pattern, Station the base
+// station the drones recharge at. Fleets with a Formation fly it around
+// their Leader with Spacing meters between slots. Search fleets see a swath
+// of Swath meters.
 type DroneFleet struct {
-	Name   string
-	Model  string
-	Drones []*telemetry.Drone
+	Name      string
+	Model     string
+	Escort    string
+	Station   string
+	Formation string
+	Spacing   float64
+	Leader    string
+	Swath     float64
+	Drones    []*telemetry.Drone
+	members   int // formation members at the last update
 }
 
 // NewSimulator initializes drones from fleet config.
@@ -140,17 +253,17 @@ func NewSimulator(clusterID string, cfg *config.SimulationConfig, writer Telemet
 	if sNoise < 0 {
 		sNoise = 0
 	}
-	terrain := cfg.TerrainOcclusion
-	if terrain < 0 {
-		terrain = 0
-	} else if terrain > 1 {
-		terrain = 1
+	occlusion := cfg.TerrainOcclusion
+	if occlusion < 0 {
+		occlusion = 0
+	} else if occlusion > 1 {
+		occlusion = 1
 	}
-	weather := cfg.WeatherImpact
-	if weather < 0 {
-		weather = 0
-	} else if weather > 1 {
-		weather = 1
+	wImpact := cfg.WeatherImpact
+	if wImpact < 0 {
+		wImpact = 0
+	} else if wImpact > 1 {
+		wImpact = 1
 	}
 	crit := 0
 	switch strings.ToLower(cfg.MissionCriticality) {
@@ -185,8 +298,9 @@ func NewSimulator(clusterID string, cfg *config.SimulationConfig, writer Telemet
 		followConfidence:      cfg.FollowConfidence,
 		detectionRadiusM:      radius,
 		sensorNoise:           sNoise,
-		terrainOcclusion:      terrain,
-		weatherImpact:         weather,
+		terrainOcclusion:      occlusion,
+		terrain:               cfg.Terrain.Grid,
+		weatherImpact:         wImpact,
 		swarmResponses:        cfg.SwarmResponses,
 		missionCriticality:    crit,
 		enemyPrevPositions:    make(map[string]telemetry.Position),
@@ -203,9 +317,13 @@ func NewSimulator(clusterID string, cfg *config.SimulationConfig, writer Telemet
 		enemyObjects:          make(map[string]*enemy.Enemy),
 		droneIndex:            make(map[string]*telemetry.Drone),
 		droneFleet:            make(map[string]*DroneFleet),
+		breaches:              make(map[string]float64),
+		events:                NewEventBus(),
 		rand:                  r,
 		now:                   now,
+		started:               now(),
 	}
+	sim.events.Subscribe(sim.handleScenarioEvent)
 
 	// Check if zones are defined
 	if len(cfg.Zones) == 0 {
@@ -213,48 +331,13 @@ func NewSimulator(clusterID string, cfg *config.SimulationConfig, writer Telemet
 		panic("No zones defined in the configuration")
 	}
 
-	// Initialize fleets
-	for _, fleet := range cfg.Fleets {
-		// Resolve the home region for this fleet. Default to the first zone if not found.
-		zone := cfg.Zones[0]
-		for _, z := range cfg.Zones {
-			if z.Name == fleet.HomeRegion {
-				zone = z
-				break
-			}
-		}
-
-		f := DroneFleet{Name: fleet.Name, Model: fleet.Model}
-		for i := 0; i < fleet.Count; i++ {
-			drone := &telemetry.Drone{
-				ID:              generateDroneID(fleet.Name, i),
-				Model:           fleet.Model,
-				MissionID:       fleet.MissionID,
-				Position:        telemetry.Position{Lat: zone.CenterLat, Lon: zone.CenterLon, Alt: 100},
-				Battery:         100,
-				Status:          telemetry.StatusOK,
-				MovementPattern: fleet.MovementPattern,
-				HomeRegion: telemetry.Region{
-					Name:      zone.Name,
-					CenterLat: zone.CenterLat,
-					CenterLon: zone.CenterLon,
-					RadiusKM:  zone.RadiusKM,
-				},
-				SensorErrorRate:    fleet.Behavior.SensorErrorRate,
-				DropoutRate:        fleet.Behavior.DropoutRate,
-				BatteryAnomalyRate: fleet.Behavior.BatteryAnomalyRate,
-			}
-			f.Drones = append(f.Drones, drone)
-		}
-		sim.fleets = append(sim.fleets, f)
+	for _, b := range cfg.BaseStations {
+		sim.addBaseStation(b)
 	}
 
-	for i := range sim.fleets {
-		f := &sim.fleets[i]
-		for _, d := range f.Drones {
-			sim.droneIndex[d.ID] = d
-			sim.droneFleet[d.ID] = f
-		}
+	// Initialize fleets
+	for _, fleet := range cfg.Fleets {
+		sim.launchFleet(fleet)
 	}
 
 	// Initialize enemy engine across all zones
@@ -264,15 +347,46 @@ func NewSimulator(clusterID string, cfg *config.SimulationConfig, writer Telemet
 	}
 	regions := make([]telemetry.Region, len(cfg.Zones))
 	for i, z := range cfg.Zones {
-		regions[i] = telemetry.Region{
-			Name:      z.Name,
-			CenterLat: z.CenterLat,
-			CenterLon: z.CenterLon,
-			RadiusKM:  z.RadiusKM,
-		}
+		regions[i] = z.Telemetry()
 	}
 	sim.enemyEng = enemy.NewEngine(count, regions, r)
 
+	for _, p := range cfg.PointsOfInterest {
+		sim.addPOI(poi.POI{
+			ID:         p.ID,
+			Type:       poi.Type(p.Type),
+			Position:   sim.onTerrain(telemetry.Position{Lat: p.Lat, Lon: p.Lon, Alt: p.Alt}),
+			Difficulty: p.Difficulty,
+		})
+	}
+	for _, c := range cfg.Convoys {
+		var route []telemetry.Position
+		for _, wp := range c.Route {
+			route = append(route, telemetry.Position{Lat: wp.Lat, Lon: wp.Lon})
+		}
+		sim.addConvoy(convoy.Convoy{ID: c.ID, SpeedMPS: c.SpeedMPS, Health: c.Health, Route: route})
+	}
+	for _, a := range cfg.FixedAssets {
+		sim.addAsset(asset.Asset{
+			ID:        a.ID,
+			Owner:     a.Owner,
+			Position:  sim.onTerrain(telemetry.Position{Lat: a.Lat, Lon: a.Lon, Alt: a.Alt}),
+			RadiusM:   a.RadiusM,
+			HitPoints: a.HitPoints,
+		})
+	}
+	for _, c := range cfg.WeatherCells {
+		sim.addWeatherCell(weather.Cell{
+			ID:              c.ID,
+			Type:            weather.Type(c.Type),
+			Position:        telemetry.Position{Lat: c.Lat, Lon: c.Lon},
+			RadiusM:         c.RadiusKM * 1000,
+			Intensity:       c.Intensity,
+			DriftSpeedMPS:   c.DriftSpeedMPS,
+			DriftHeadingDeg: c.DriftHeadingDeg,
+		})
+	}
+
 	return sim
 }
 
@@ -280,6 +394,10 @@ func NewSimulator(clusterID string, cfg *config.SimulationConfig, writer Telemet
 func (s *Simulator) SpawnEnemy(en enemy.Enemy) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
+	s.spawnEnemy(en)
+}
+
+func (s *Simulator) spawnEnemy(en enemy.Enemy) {
 	if s.enemyEng == nil {
 		s.enemyEng = enemy.NewEngine(0, nil, s.rand)
 	}
@@ -319,20 +437,27 @@ func (s *Simulator) RemoveEnemy(id string) {
 	s.removeEnemy(id)
 }
 
-// UpdateEnemyStatus sets the status field for an existing enemy.
+// UpdateEnemyStatus sets the status field for an existing enemy. Neutralizing
+// an active enemy publishes an enemy_destroyed event.
 func (s *Simulator) UpdateEnemyStatus(id string, st enemy.EnemyStatus) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
+	wasActive := false
 	if s.enemyEng != nil {
 		for _, e := range s.enemyEng.Enemies {
 			if e.ID == id {
+				wasActive = wasActive || e.Status == enemy.EnemyActive
 				e.Status = st
 			}
 		}
 	}
 	if en, ok := s.enemyObjects[id]; ok {
+		wasActive = wasActive || en.Status == enemy.EnemyActive
 		en.Status = st
 	}
+	if wasActive && st == enemy.EnemyNeutralized {
+		s.publish(scenario.EventEnemyDestroyed, id)
+	}
 }
 
 // ToggleChaos flips chaos mode on or off and returns the new state.
@@ -355,29 +480,189 @@ func (s *Simulator) Chaos() bool {
 func (s *Simulator) LaunchSwarm(model string, count int) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	region := s.cfg.Zones[0]
-	fleetName := model // Use model name directly as fleet name
-	f := DroneFleet{Name: fleetName, Model: model}
-	for i := 0; i < count; i++ {
+	s.launchFleet(config.Fleet{Name: model, Model: model, Count: count})
+	s.logObserverEvent("launch_swarm", fmt.Sprintf("model=%s count=%d", model, count))
+}
+
+// launchFleet adds the configured drones at the centre of the fleet's home
+// zone, defaulting to the first zone. Drones launched under the name of an
+// existing fleet join it and continue its ID sequence.
+func (s *Simulator) launchFleet(fleet config.Fleet) {
+	if s.droneIndex == nil {
+		s.droneIndex = make(map[string]*telemetry.Drone)
+	}
+	if s.droneFleet == nil {
+		s.droneFleet = make(map[string]*DroneFleet)
+	}
+	zone := s.cfg.Zones[0]
+	for _, z := range s.cfg.Zones {
+		if z.Name == fleet.HomeRegion {
+			zone = z
+			break
+		}
+	}
+
+	idx := -1
+	for i := range s.fleets {
+		if s.fleets[i].Name == fleet.Name {
+			idx = i
+			break
+		}
+	}
+	if idx < 0 {
+		s.fleets = append(s.fleets, DroneFleet{
+			Name:      fleet.Name,
+			Model:     fleet.Model,
+			Escort:    fleet.Escort,
+			Station:   fleet.BaseStation,
+			Formation: fleet.Formation,
+			Spacing:   fleet.FormationSpacingM,
+		})
+		idx = len(s.fleets) - 1
+	}
+	f := &s.fleets[idx]
+	// Fleets with a base or base station launch from the ground, others
+	// start on station above the zone center, or a point inside polygon
+	// zones whose center lies outside them, and return there. Base
+	// altitudes are heights above the terrain, which every pad rests on.
+	cruise := fleet.CruiseAltM
+	if cruise <= 0 {
+		cruise = telemetry.DefaultCruiseAltM
+	}
+	// Over a terrain model fleets follow the terrain unless they ask for
+	// altitudes above mean sea level
+	mode := fleet.AltitudeMode
+	if mode == "" && s.terrain != nil {
+		mode = telemetry.AltitudeTerrainFollowing
+	}
+	lat, lon := zone.Telemetry().Anchor()
+	base := telemetry.Position{Lat: lat, Lon: lon}
+	launch := telemetry.Position{Lat: lat, Lon: lon, Alt: cruise}
+	phase := telemetry.PhaseOnStation
+	if fleet.Base != nil {
+		base = telemetry.Position{Lat: fleet.Base.Lat, Lon: fleet.Base.Lon, Alt: fleet.Base.Alt}
+		launch, phase = base, telemetry.PhaseIdle
+	}
+	if fleet.BaseStation != "" {
+		if st := s.baseStation(fleet.BaseStation); st != nil {
+			base = st.Position
+			base.Alt -= s.elevation(base.Lat, base.Lon)
+			launch, phase = base, telemetry.PhaseIdle
+		} else {
+			log.Warn("unknown base station, drones do not recharge", "fleet", fleet.Name, "base_station", fleet.BaseStation)
+		}
+	}
+	if ground := math.Max(s.elevation(lat, lon), s.elevation(launch.Lat, launch.Lon)); s.terrain != nil && mode != telemetry.AltitudeTerrainFollowing && cruise <= ground {
+		log.Warn("cruise altitude below the terrain, drones fly on the ground", "fleet", fleet.Name, "cruise_alt_m", cruise, "ground_m", ground)
+	}
+	if err := telemetry.ValidateParams(fleet.MovementPattern, fleet.PatternParams); fleet.MovementPattern != "" && err != nil {
+		log.Warn("invalid movement pattern, drones may walk randomly", "fleet", fleet.Name, "err", err)
+	}
+	route, ok := s.route(fleet.Route, cruise)
+	if !ok {
+		log.Warn("unknown route, drones keep their position", "fleet", fleet.Name, "route", fleet.Route)
+	}
+	// Search fleets split the search region into one lane per drone
+	var search telemetry.Region
+	if fleet.MovementPattern == "search" {
+		f.Swath = fleet.SwathWidthM
+		if f.Swath <= 0 {
+			f.Swath = telemetry.DefaultSwathWidthM
+		}
+		search = s.searchRegion(fleet, zone)
+		s.coverageGrid(fleet.MissionID, search, f.Swath)
+	}
+	// Every drone gets its own pad, one separation apart
+	sep := telemetry.ModelLimits(fleet.Model).MinSeparationM
+	pads := len(f.Drones) + fleet.Count
+	n := len(f.Drones)
+	for i := 0; i < fleet.Count; i++ {
+		pad := len(f.Drones)
+		id := generateDroneID(fleet.Name, n)
+		for s.droneIndex[id] != nil {
+			n++
+			id = generateDroneID(fleet.Name, n)
+		}
+		n++
+		pos := padPosition(launch, pad, pads, sep)
+		if phase == telemetry.PhaseIdle || mode == telemetry.AltitudeTerrainFollowing {
+			pos = s.onTerrain(pos)
+		}
 		drone := &telemetry.Drone{
-			ID:       generateDroneID(fleetName, i),
-			Model:    model,
-			Position: telemetry.Position{Lat: region.CenterLat, Lon: region.CenterLon, Alt: 100},
-			Battery:  100,
-			Status:   telemetry.StatusOK,
+			ID:              id,
+			Model:           fleet.Model,
+			MissionID:       fleet.MissionID,
+			Position:        pos,
+			Battery:         100,
+			Status:          telemetry.StatusOK,
+			MovementPattern: fleet.MovementPattern,
+			PatternParams:   telemetry.Params(fleet.PatternParams),
+			HomeRegion:      zone.Telemetry(),
+			Phase:           phase,
+			Base:            s.onTerrain(padPosition(base, pad, pads, sep)),
+			CruiseAltM:      cruise,
+			AltitudeMode:    mode,
+			Waypoints:       route.Waypoints,
+			RouteMode:       route.Mode,
+			ArrivalRadiusM:  route.ArrivalRadiusM,
+			Behavior:        telemetry.Behavior(fleet.Behavior),
+		}
+		if s.terrain != nil {
+			drone.Terrain = s.terrain
+		}
+		if f.Swath > 0 {
+			drone.Waypoints = telemetry.SearchWaypoints(fleet.SearchPattern, search, pad, pads, f.Swath, cruise)
+			drone.RouteMode = telemetry.RoutePingPong
 		}
 		f.Drones = append(f.Drones, drone)
+		s.droneIndex[id] = drone
+	}
+
+	// appending to s.fleets may have moved the fleets, so refresh every pointer
+	for i := range s.fleets {
+		for _, d := range s.fleets[i].Drones {
+			s.droneFleet[d.ID] = &s.fleets[i]
+		}
 	}
-	s.fleets = append(s.fleets, f)
-	s.logObserverEvent("launch_swarm", fmt.Sprintf("model=%s count=%d", model, count))
 }
 
-// FleetHealth summarizes status counts per fleet.
+// flightRoute is a configured route converted for point-to-point movement.
+type flightRoute struct {
+	Mode           string
+	ArrivalRadiusM float64
+	Waypoints      []telemetry.Position
+}
+
+// route looks up a configured route by name. Waypoints without an altitude
+// are flown at alt. An empty name is no route and always found.
+func (s *Simulator) route(name string, alt float64) (flightRoute, bool) {
+	if name == "" {
+		return flightRoute{}, true
+	}
+	for _, r := range s.cfg.Routes {
+		if r.Name != name {
+			continue
+		}
+		fr := flightRoute{Mode: r.Mode, ArrivalRadiusM: r.ArrivalRadiusM}
+		for _, wp := range r.Waypoints {
+			p := telemetry.Position{Lat: wp.Lat, Lon: wp.Lon, Alt: wp.Alt}
+			if p.Alt == 0 {
+				p.Alt = alt
+			}
+			fr.Waypoints = append(fr.Waypoints, p)
+		}
+		return fr, true
+	}
+	return flightRoute{}, false
+}
+
+// FleetHealth summarizes status and lifecycle phase counts per fleet.
 type FleetHealth struct {
-	Name       string `json:"name"`
-	Total      int    `json:"total"`
-	LowBattery int    `json:"low_battery"`
-	Failed     int    `json:"failed"`
+	Name       string         `json:"name"`
+	Total      int            `json:"total"`
+	LowBattery int            `json:"low_battery"`
+	Failed     int            `json:"failed"`
+	Phases     map[string]int `json:"phases"`
 }
 
 // Health returns aggregated health information for all fleets.
@@ -386,8 +671,9 @@ func (s *Simulator) Health() []FleetHealth {
 	defer s.mu.Unlock()
 	var result []FleetHealth
 	for _, f := range s.fleets {
-		h := FleetHealth{Name: f.Name, Total: len(f.Drones)}
+		h := FleetHealth{Name: f.Name, Total: len(f.Drones), Phases: make(map[string]int)}
 		for _, d := range f.Drones {
+			h.Phases[d.Phase]++
 			switch d.Status {
 			case telemetry.StatusFailure:
 				h.Failed++
@@ -424,6 +710,7 @@ func (s *Simulator) TelemetrySnapshot() []telemetry.TelemetryRow {
 				Battery:   drone.Battery,
 				Status:    drone.Status,
 				Follow:    drone.FollowTarget != nil,
+				Phase:     drone.Phase,
 				Timestamp: s.now().UTC(),
 			})
 		}
@@ -431,7 +718,7 @@ func (s *Simulator) TelemetrySnapshot() []telemetry.TelemetryRow {
 	return rows
 }
 
-// MapSnapshot returns simplified drone and enemy data for the 3D map.
+// MapSnapshot returns simplified drone, enemy, point of interest, convoy, asset and weather data for the 3D map.
 func (s *Simulator) MapSnapshot() MapData {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -444,6 +731,7 @@ func (s *Simulator) MapSnapshot() MapData {
 				Lon:     d.Position.Lon,
 				Alt:     d.Position.Alt,
 				Battery: d.Battery,
+				Phase:   d.Phase,
 			}
 			if d.FollowTarget != nil {
 				md.FollowLat = &d.FollowTarget.Lat
@@ -465,8 +753,50 @@ func (s *Simulator) MapSnapshot() MapData {
 			})
 		}
 	}
+	var pois []MapPOI
+	for _, p := range s.pois {
+		pois = append(pois, MapPOI{
+			ID:     p.ID,
+			Type:   p.Type,
+			Status: p.Status,
+			Lat:    p.Position.Lat,
+			Lon:    p.Position.Lon,
+			Alt:    p.Position.Alt,
+		})
+	}
+	var convoys []MapConvoy
+	for _, c := range s.convoys {
+		mc := MapConvoy{
+			ID:     c.ID,
+			Status: c.Status,
+			Health: c.Health,
+			Lat:    c.Position.Lat,
+			Lon:    c.Position.Lon,
+			Alt:    c.Position.Alt,
+		}
+		for _, wp := range c.Route[min(c.Waypoint, len(c.Route)):] {
+			mc.Route = append(mc.Route, MapPoint{Lat: wp.Lat, Lon: wp.Lon})
+		}
+		convoys = append(convoys, mc)
+	}
+	var assets []MapAsset
+	for _, a := range s.assets {
+		assets = append(assets, MapAsset{
+			ID:      a.ID,
+			Owner:   a.Owner,
+			Status:  a.Status,
+			Health:  a.Health(),
+			Lat:     a.Position.Lat,
+			Lon:     a.Position.Lon,
+			Alt:     a.Position.Alt,
+			RadiusM: a.RadiusM,
+		})
+	}
 	var missions []MapMission
+	var zones []MapZone
+	var restricted []MapRestrictedArea
 	if s.cfg != nil {
+		restricted = s.mapRestrictedAreas()
 		for _, m := range s.cfg.Missions {
 			missions = append(missions, MapMission{
 				ID:       m.ID,
@@ -474,36 +804,30 @@ func (s *Simulator) MapSnapshot() MapData {
 				Lat:      m.Region.CenterLat,
 				Lon:      m.Region.CenterLon,
 				RadiusKM: m.Region.RadiusKM,
+				Polygons: mapPolygons(m.Region.Shape),
+			})
+		}
+		for _, z := range s.cfg.Zones {
+			zones = append(zones, MapZone{
+				Name:     z.Name,
+				Lat:      z.CenterLat,
+				Lon:      z.CenterLon,
+				RadiusKM: z.RadiusKM,
+				Polygons: mapPolygons(z.Shape),
 			})
 		}
 	}
-	return MapData{Drones: drones, Enemies: enemies, Missions: missions}
+	return MapData{Drones: drones, Enemies: enemies, POIs: pois, Convoys: convoys, Assets: assets, Weather: s.mapWeather(), Missions: missions, Zones: zones, RestrictedAreas: restricted}
 }
 
-func generateDroneID(fleetName string, index int) string {
-	return fmt.Sprintf("%s-%d", fleetName, index)
+// mapPolygons returns the coordinates of a polygon region, nil for circles.
+func mapPolygons(shape geo.MultiPolygon) [][][][2]float64 {
+	if shape == nil {
+		return nil
+	}
+	return shape.Coordinates()
 }
 
-// distanceMeters calculates the haversine distance between two lat/lon points.
-func distanceMeters(lat1, lon1, lat2, lon2 float64) float64 {
-	const earthRadius = 6371000.0
-	dLat := (lat2 - lat1) * math.Pi / 180
-	dLon := (lon2 - lon1) * math.Pi / 180
-	a := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1*math.Pi/180)*math.Cos(lat2*math.Pi/180)*math.Sin(dLon/2)*math.Sin(dLon/2)
-	c := 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
-	return earthRadius * c
-}
-
-// bearingDegrees calculates the initial bearing from point A to B.
-func bearingDegrees(lat1, lon1, lat2, lon2 float64) float64 {
-	lat1Rad := lat1 * math.Pi / 180
-	lat2Rad := lat2 * math.Pi / 180
-	dLon := (lon2 - lon1) * math.Pi / 180
-	y := math.Sin(dLon) * math.Cos(lat2Rad)
-	x := math.Cos(lat1Rad)*math.Sin(lat2Rad) - math.Sin(lat1Rad)*math.Cos(lat2Rad)*math.Cos(dLon)
-	brng := math.Atan2(y, x) * 180 / math.Pi
-	if brng < 0 {
-		brng += 360
-	}
-	return brng
+func generateDroneID(fleetName string, index int) string {
+	return fmt.Sprintf("%s-%d", fleetName, index)
 }
@@ -10,6 +10,7 @@ import (
 
 	"droneops-sim/internal/config"
 	"droneops-sim/internal/enemy"
+	"droneops-sim/internal/geo"
 	"droneops-sim/internal/telemetry"
 )
 
@@ -112,6 +113,64 @@ func TestSimulator_DefaultsToFirstZone(t *testing.T) {
 	}
 }
 
+func TestSimulator_FleetFollowsRoute(t *testing.T) {
+	cfg := &config.SimulationConfig{
+		Zones: []config.Region{{Name: "zone", CenterLat: 0, CenterLon: 0, RadiusKM: 10}},
+		Routes: []config.Route{{Name: "run", Mode: "one-way", Waypoints: []config.Waypoint{
+			{Lat: 0, Lon: 0.0005}, {Lat: 0.0005, Lon: 0.0005, Alt: 150},
+		}}},
+		Fleets: []config.Fleet{{Name: "f1", Model: "small-fpv", Count: 1, MovementPattern: "point-to-point", HomeRegion: "zone", Route: "run"}},
+	}
+	writer := &MockWriter{}
+	sim := NewSimulator("cluster", cfg, writer, nil, time.Second, rand.New(rand.NewSource(1)), func() time.Time { return time.Unix(0, 0).UTC() })
+	sim.enemyEng.Enemies = nil
+	drone := sim.fleets[0].Drones[0]
+	if len(drone.Waypoints) != 2 || drone.Waypoints[0].Alt != 100 || drone.RouteMode != "one-way" {
+		t.Fatalf("expected route on drone, got %+v", drone)
+	}
+	for i := 0; i < 10; i++ {
+		sim.tick(context.Background())
+	}
+	last := writer.Rows[len(writer.Rows)-1]
+	if last.WaypointIndex != 1 || geo.Distance(last.Lat, last.Lon, 0.0005, 0.0005) > 1 || last.Alt != 150 {
+		t.Fatalf("expected drone to hold at the last waypoint, got %+v", last)
+	}
+}
+
+func TestSimulator_FleetLaunchesFromBase(t *testing.T) {
+	cfg := &config.SimulationConfig{
+		Zones: []config.Region{{Name: "zone", CenterLat: 0, CenterLon: 0, RadiusKM: 0.5}},
+		Fleets: []config.Fleet{
+			{Name: "based", Model: "small-fpv", Count: 2, MovementPattern: "patrol", HomeRegion: "zone", Base: &config.Waypoint{Lat: 0.01, Lon: 0.01}, CruiseAltM: 80},
+			{Name: "legacy", Model: "small-fpv", Count: 1, MovementPattern: "patrol", HomeRegion: "zone"},
+		},
+	}
+	sim := NewSimulator("cluster", cfg, &MockWriter{}, nil, time.Second, rand.New(rand.NewSource(1)), func() time.Time { return time.Unix(0, 0).UTC() })
+	sim.enemyEng.Enemies = nil
+	d := sim.fleets[0].Drones[0]
+	if d.Phase != telemetry.PhaseIdle || d.Position.Alt != 0 || d.Position.Lat != 0.01 {
+		t.Fatalf("expected drone on the ground at its base, got %+v", d)
+	}
+	if l := sim.fleets[1].Drones[0]; l.Phase != telemetry.PhaseOnStation || l.Position.Alt != telemetry.DefaultCruiseAltM {
+		t.Fatalf("expected drone without base on station, got %+v", l)
+	}
+
+	sim.tick(context.Background())
+	health := sim.Health()
+	if health[0].Phases[telemetry.PhaseTakeoff] != 2 || health[1].Phases[telemetry.PhaseOnStation] != 1 {
+		t.Fatalf("unexpected phase counts: %+v", health)
+	}
+	if sim.selectReplacement() != sim.fleets[1].Drones[0] {
+		t.Fatalf("expected only the drone on station to be available for tasks")
+	}
+	for i := 0; i < 20; i++ {
+		sim.tick(context.Background())
+	}
+	if d.Phase != telemetry.PhaseTransit || d.Position.Alt != 80 {
+		t.Fatalf("expected drone in transit at cruise altitude, got %s at %.1f m", d.Phase, d.Position.Alt)
+	}
+}
+
 func TestSimulator_SensorErrorRate(t *testing.T) {
 	rand.Seed(1)
 	cfg := &config.SimulationConfig{
@@ -229,7 +288,7 @@ func TestUpdateDroneDropout(t *testing.T) {
 	}
 	sim := NewSimulator("cluster", cfg, &MockWriter{}, nil, time.Second, rand.New(rand.NewSource(1)), func() time.Time { return time.Unix(0, 0).UTC() })
 	drone := sim.fleets[0].Drones[0]
-	drone.DropoutRate = 1
+	drone.Behavior.DropoutRate = 1
 	if _, ok := sim.updateDrone(drone); ok {
 		t.Fatalf("expected updateDrone to indicate dropout")
 	}
@@ -511,7 +570,7 @@ func TestSimulator_DetectionFactorsReduceConfidence(t *testing.T) {
 		t.Fatalf("expected enemy detection event")
 	}
 	det := dWriter.Detections[0]
-	dist := distanceMeters(drone.Position.Lat, drone.Position.Lon, det.Lat, det.Lon)
+	dist := geo.Distance(drone.Position.Lat, drone.Position.Lon, det.Lat, det.Lon)
 	expected := 100 * (1 - dist/cfg.DetectionRadiusM) * (1 - cfg.TerrainOcclusion) * (1 - cfg.WeatherImpact)
 	if math.Abs(det.Confidence-expected) > 0.01 {
 		t.Errorf("expected confidence %.2f, got %.2f", expected, det.Confidence)
@@ -719,9 +778,7 @@ func TestSimulator_RebalanceFormation(t *testing.T) {
 	region := orig
 	radius := region.RadiusKM * 1000 * 0.5
 	for i, c := range centers {
-		angle := float64(i) / float64(len(centers)) * 2 * math.Pi
-		expLat := region.CenterLat + (radius*math.Cos(angle))/111000
-		expLon := region.CenterLon + (radius*math.Sin(angle))/(111000*math.Cos(region.CenterLat*math.Pi/180))
+		expLat, expLon := geo.Destination(region.CenterLat, region.CenterLon, float64(i)/float64(len(centers))*360, radius)
 		if math.Abs(c.Lat-expLat) > 1e-6 || math.Abs(c.Lon-expLon) > 1e-6 {
 			t.Errorf("drone %d center (%.6f, %.6f), expected (%.6f, %.6f)", i, c.Lat, c.Lon, expLat, expLon)
 		}
@@ -943,15 +1000,15 @@ func TestProcessDetectionsPopulatesFields(t *testing.T) {
 	if det.DroneLat != drone.Position.Lat || det.DroneLon != drone.Position.Lon {
 		t.Fatalf("missing drone coords: %+v", det)
 	}
-	expDist := distanceMeters(drone.Position.Lat, drone.Position.Lon, en.Position.Lat, en.Position.Lon)
+	expDist := geo.Distance(drone.Position.Lat, drone.Position.Lon, en.Position.Lat, en.Position.Lon)
 	if math.Abs(det.DistanceM-expDist) > 0.1 {
 		t.Fatalf("distance mismatch: got %f want %f", det.DistanceM, expDist)
 	}
-	expBearing := bearingDegrees(drone.Position.Lat, drone.Position.Lon, en.Position.Lat, en.Position.Lon)
+	expBearing := geo.Bearing(drone.Position.Lat, drone.Position.Lon, en.Position.Lat, en.Position.Lon)
 	if math.Abs(det.BearingDeg-expBearing) > 0.1 {
 		t.Fatalf("bearing mismatch: got %f want %f", det.BearingDeg, expBearing)
 	}
-	expVel := distanceMeters(drone.Position.Lat, drone.Position.Lon, en.Position.Lat, en.Position.Lon) / sim.tickInterval.Seconds()
+	expVel := geo.Distance(drone.Position.Lat, drone.Position.Lon, en.Position.Lat, en.Position.Lon) / sim.tickInterval.Seconds()
 	if math.Abs(det.EnemyVelMS-expVel) > 0.1 {
 		t.Fatalf("velocity mismatch: got %f want %f", det.EnemyVelMS, expVel)
 	}
@@ -1017,3 +1074,48 @@ func TestSimulatorPrunesEnemiesAndStabilizesDetections(t *testing.T) {
 		}
 	}
 }
+
+func TestMapSnapshotPolygonRegions(t *testing.T) {
+	shape := geo.MultiPolygon{{geo.Ring{{Lat: 0, Lon: 0}, {Lat: 0, Lon: 0.02}, {Lat: 0.02, Lon: 0}}}}
+	cfg := &config.SimulationConfig{
+		Zones:    []config.Region{{Name: "z", CenterLat: 0.01, CenterLon: 0.01, RadiusKM: 2, Shape: shape}},
+		Missions: []config.Mission{{ID: "m", Name: "m", Region: config.Region{Name: "r", CenterLat: 0, CenterLon: 0, RadiusKM: 1}}},
+	}
+	sim := NewSimulator("c", cfg, nil, nil, time.Second, rand.New(rand.NewSource(1)), nil)
+	data := sim.MapSnapshot()
+	if len(data.Zones) != 1 || len(data.Zones[0].Polygons) != 1 || len(data.Zones[0].Polygons[0][0]) != 4 {
+		t.Fatalf("expected the zone's closed polygon on the map, got %+v", data.Zones)
+	}
+	if len(data.Missions) != 1 || data.Missions[0].Polygons != nil || data.Missions[0].RadiusKM != 1 {
+		t.Fatalf("expected the circular mission without polygons, got %+v", data.Missions)
+	}
+}
+
+func TestConcaveZoneKeepsPatrolsInside(t *testing.T) {
+	// A U open to the north, about 3 km across, its bounding box center in the notch
+	shape := geo.MultiPolygon{{geo.Ring{{Lat: 0, Lon: 0}, {Lat: 0, Lon: 0.03}, {Lat: 0.03, Lon: 0.03}, {Lat: 0.03, Lon: 0.02},
+		{Lat: 0.01, Lon: 0.02}, {Lat: 0.01, Lon: 0.01}, {Lat: 0.03, Lon: 0.01}, {Lat: 0.03, Lon: 0}}}}
+	lat, lon, radiusM := shape.BoundingCircle()
+	cfg := &config.SimulationConfig{
+		Zones:  []config.Region{{Name: "u", CenterLat: lat, CenterLon: lon, RadiusKM: radiusM / 1000, Shape: shape}},
+		Fleets: []config.Fleet{{Name: "f", Model: "small-fpv", Count: 3, MovementPattern: "patrol", HomeRegion: "u"}},
+	}
+	sim := NewSimulator("c", cfg, &MockWriter{}, &MockDetectionWriter{}, time.Second, rand.New(rand.NewSource(1)), nil)
+	for _, d := range sim.fleets[0].Drones {
+		if !shape.Contains(d.Position.Lat, d.Position.Lon) {
+			t.Fatalf("expected %s to spawn inside the U, got %+v", d.ID, d.Position)
+		}
+	}
+	outside := 0
+	for i := 0; i < 600; i++ {
+		sim.tick(context.Background())
+		for _, d := range sim.fleets[0].Drones {
+			if !shape.Contains(d.Position.Lat, d.Position.Lon) {
+				outside++
+			}
+		}
+	}
+	if outside > 0 {
+		t.Fatalf("expected the patrols to stay inside the U, got %d positions outside", outside)
+	}
+}
@@ -11,6 +11,7 @@ import (
 
 	"droneops-sim/internal/config"
 	"droneops-sim/internal/enemy"
+	"droneops-sim/internal/poi"
 	"droneops-sim/internal/telemetry"
 )
 
@@ -148,6 +149,24 @@ func (w *ColorStdoutWriter) WriteDetections(rows []enemy.DetectionRow) error {
 	return nil
 }
 
+// WritePOIDetection prints a point of interest detection to STDOUT.
+func (w *ColorStdoutWriter) WritePOIDetection(d poi.DetectionRow) error {
+	w.once.Do(w.printOverview)
+	fmt.Fprintf(w.out, "%s[%s]%s %sPOI%s drone=%s poi=%s type=%s status=%s lat=%.5f lon=%.5f conf=%.2f\n",
+		colorGray, d.Timestamp.Format(time.RFC3339), colorReset,
+		colorGreen, colorReset, d.DroneID, d.POIID, d.POIType, d.Status,
+		d.Lat, d.Lon, d.Confidence)
+	return nil
+}
+
+// WritePOIDetections prints multiple point of interest detections.
+func (w *ColorStdoutWriter) WritePOIDetections(rows []poi.DetectionRow) error {
+	for _, d := range rows {
+		_ = w.WritePOIDetection(d)
+	}
+	return nil
+}
+
 // WriteSwarmEvent prints a swarm coordination event to STDOUT.
 func (w *ColorStdoutWriter) WriteSwarmEvent(e telemetry.SwarmEventRow) error {
 	w.once.Do(w.printOverview)
@@ -157,6 +176,12 @@ func (w *ColorStdoutWriter) WriteSwarmEvent(e telemetry.SwarmEventRow) error {
 	if e.EnemyID != "" {
 		fmt.Fprintf(w.out, " enemy=%s", e.EnemyID)
 	}
+	if e.Formation != "" {
+		fmt.Fprintf(w.out, " formation=%s leader=%s", e.Formation, e.LeaderID)
+	}
+	if e.DistanceM > 0 {
+		fmt.Fprintf(w.out, " distance=%.1fm", e.DistanceM)
+	}
 	fmt.Fprintln(w.out)
 	return nil
 }
@@ -169,13 +194,34 @@ func (w *ColorStdoutWriter) WriteSwarmEvents(rows []telemetry.SwarmEventRow) err
 	return nil
 }
 
+// WriteGeofenceBreach prints a drone entering or leaving restricted airspace to STDOUT.
+func (w *ColorStdoutWriter) WriteGeofenceBreach(r telemetry.GeofenceBreachRow) error {
+	w.once.Do(w.printOverview)
+	fmt.Fprintf(w.out, "%s[%s]%s %sGEOFENCE%s %s drone=%s zone=%s depth=%.1fm lat=%.5f lon=%.5f alt=%.1f\n",
+		colorGray, r.Timestamp.Format(time.RFC3339), colorReset,
+		colorRed, colorReset, r.Event, r.DroneID, r.ZoneID, r.PenetrationM, r.Lat, r.Lon, r.Alt)
+	return nil
+}
+
+// WriteGeofenceBreaches prints multiple geofence breaches.
+func (w *ColorStdoutWriter) WriteGeofenceBreaches(rows []telemetry.GeofenceBreachRow) error {
+	for _, r := range rows {
+		_ = w.WriteGeofenceBreach(r)
+	}
+	return nil
+}
+
 // WriteState prints simulation state metrics to STDOUT.
 func (w *ColorStdoutWriter) WriteState(row telemetry.SimulationStateRow) error {
 	w.once.Do(w.printOverview)
-	fmt.Fprintf(w.out, "%s[%s]%s %sSTATE%s comm_loss=%.2f msgs=%d sensor_noise=%.2f weather=%.2f chaos=%t\n",
+	fmt.Fprintf(w.out, "%s[%s]%s %sSTATE%s comm_loss=%.2f msgs=%d sensor_noise=%.2f weather=%.2f wind=%.0f°@%.1fm/s chaos=%t",
 		colorGray, row.Timestamp.Format(time.RFC3339), colorReset,
 		colorBlue, colorReset, row.CommunicationLoss, row.MessagesSent,
-		row.SensorNoise, row.WeatherImpact, row.ChaosMode)
+		row.SensorNoise, row.WeatherImpact, row.WindDirectionDeg, row.WindSpeedMPS, row.ChaosMode)
+	for _, c := range row.Coverage {
+		fmt.Fprintf(w.out, " coverage[%s]=%.1f%%", c.MissionID, c.CoveragePct)
+	}
+	fmt.Fprintln(w.out)
 	return nil
 }
 
@@ -6,7 +6,10 @@ import (
 	"io"
 	"os"
 
+	"droneops-sim/internal/asset"
+	"droneops-sim/internal/convoy"
 	"droneops-sim/internal/enemy"
+	"droneops-sim/internal/poi"
 	"droneops-sim/internal/telemetry"
 )
 
@@ -80,6 +83,81 @@ func (w *JSONStdoutWriter) WriteStates(rows []telemetry.SimulationStateRow) erro
 	return nil
 }
 
+// WritePOIDetection outputs a point of interest detection row in JSON format.
+func (w *JSONStdoutWriter) WritePOIDetection(row poi.DetectionRow) error {
+	data, _ := json.Marshal(row)
+	fmt.Fprintln(w.out, string(data))
+	return nil
+}
+
+// WritePOIDetections outputs multiple point of interest detection rows in JSON format.
+func (w *JSONStdoutWriter) WritePOIDetections(rows []poi.DetectionRow) error {
+	for _, r := range rows {
+		_ = w.WritePOIDetection(r)
+	}
+	return nil
+}
+
+// WritePhase outputs a scenario phase row in JSON format.
+func (w *JSONStdoutWriter) WritePhase(row telemetry.ScenarioPhaseRow) error {
+	data, _ := json.Marshal(row)
+	fmt.Fprintln(w.out, string(data))
+	return nil
+}
+
+// WritePhases outputs multiple scenario phase rows in JSON format.
+func (w *JSONStdoutWriter) WritePhases(rows []telemetry.ScenarioPhaseRow) error {
+	for _, r := range rows {
+		_ = w.WritePhase(r)
+	}
+	return nil
+}
+
+// WriteConvoy outputs a convoy state row in JSON format.
+func (w *JSONStdoutWriter) WriteConvoy(row convoy.StateRow) error {
+	data, _ := json.Marshal(row)
+	fmt.Fprintln(w.out, string(data))
+	return nil
+}
+
+// WriteConvoys outputs multiple convoy state rows in JSON format.
+func (w *JSONStdoutWriter) WriteConvoys(rows []convoy.StateRow) error {
+	for _, r := range rows {
+		_ = w.WriteConvoy(r)
+	}
+	return nil
+}
+
+// WriteAsset outputs a fixed asset state row in JSON format.
+func (w *JSONStdoutWriter) WriteAsset(row asset.StateRow) error {
+	data, _ := json.Marshal(row)
+	fmt.Fprintln(w.out, string(data))
+	return nil
+}
+
+// WriteAssets outputs multiple fixed asset state rows in JSON format.
+func (w *JSONStdoutWriter) WriteAssets(rows []asset.StateRow) error {
+	for _, r := range rows {
+		_ = w.WriteAsset(r)
+	}
+	return nil
+}
+
+// WriteGeofenceBreach outputs a geofence breach row in JSON format.
+func (w *JSONStdoutWriter) WriteGeofenceBreach(row telemetry.GeofenceBreachRow) error {
+	data, _ := json.Marshal(row)
+	fmt.Fprintln(w.out, string(data))
+	return nil
+}
+
+// WriteGeofenceBreaches outputs multiple geofence breach rows in JSON format.
+func (w *JSONStdoutWriter) WriteGeofenceBreaches(rows []telemetry.GeofenceBreachRow) error {
+	for _, r := range rows {
+		_ = w.WriteGeofenceBreach(r)
+	}
+	return nil
+}
+
 // WriteMission outputs a mission row in JSON format.
 func (w *JSONStdoutWriter) WriteMission(row telemetry.MissionRow) error {
 	data, _ := json.Marshal(row)
@@ -9,6 +9,7 @@ import (
 
 	"droneops-sim/internal/config"
 	"droneops-sim/internal/enemy"
+	"droneops-sim/internal/poi"
 	"droneops-sim/internal/telemetry"
 )
 
@@ -30,6 +31,8 @@ func TestJSONStdoutWriter(t *testing.T) {
 	dRow := enemy.DetectionRow{ClusterID: "c1", DroneID: "d1", EnemyID: "e1", Timestamp: ts}
 	sRow := telemetry.SwarmEventRow{ClusterID: "c1", EventType: telemetry.SwarmEventAssignment, DroneIDs: []string{"d1"}, EnemyID: "e1", Timestamp: ts}
 	stRow := telemetry.SimulationStateRow{ClusterID: "c1", MessagesSent: 1, ChaosMode: true, Timestamp: ts}
+	poiRow := poi.DetectionRow{ClusterID: "c1", DroneID: "d1", POIID: "s1", POIType: poi.Wreckage, Status: poi.StatusMissing, Timestamp: ts}
+	pRow := telemetry.ScenarioPhaseRow{ClusterID: "c1", Scenario: "Escort", Phase: "setup", Transition: telemetry.PhaseTransitionEnter, Timestamp: ts}
 	mRow := telemetry.MissionRow{ID: "m1", Name: "Mission", Objective: "Obj", Description: "Desc", Region: telemetry.Region{Name: "R", CenterLat: 1, CenterLon: 2, RadiusKM: 3}}
 
 	cases := []struct {
@@ -93,6 +96,34 @@ func TestJSONStdoutWriter(t *testing.T) {
 				return nil
 			},
 		},
+		{
+			name:  "phase",
+			write: func(w *JSONStdoutWriter) error { return w.WritePhase(pRow) },
+			decode: func(b []byte) error {
+				var got telemetry.ScenarioPhaseRow
+				if err := json.Unmarshal(b, &got); err != nil {
+					return err
+				}
+				if got.Phase != pRow.Phase || got.Transition != pRow.Transition {
+					t.Fatalf("unexpected phase row: %#v", got)
+				}
+				return nil
+			},
+		},
+		{
+			name:  "poi",
+			write: func(w *JSONStdoutWriter) error { return w.WritePOIDetection(poiRow) },
+			decode: func(b []byte) error {
+				var got poi.DetectionRow
+				if err := json.Unmarshal(b, &got); err != nil {
+					return err
+				}
+				if got != poiRow {
+					t.Fatalf("unexpected poi detection: %#v", got)
+				}
+				return nil
+			},
+		},
 		{
 			name:  "mission",
 			write: func(w *JSONStdoutWriter) error { return w.WriteMission(mRow) },
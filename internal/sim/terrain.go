@@ -0,0 +1,53 @@
+package sim
+
+import (
+	"math"
+
+	"droneops-sim/internal/enemy"
+	"droneops-sim/internal/telemetry"
+)
+
+// targetHeightM is the height above its position a drone has to see over
+// the terrain to detect a ground target.
+const targetHeightM = 2.0
+
+// elevation returns the ground height at lat, lon, sea level without a
+// terrain model.
+func (s *Simulator) elevation(lat, lon float64) float64 {
+	if s.terrain == nil {
+		return 0
+	}
+	return s.terrain.Elevation(lat, lon)
+}
+
+// onTerrain converts a position whose altitude is a height above the ground
+// to one above mean sea level.
+func (s *Simulator) onTerrain(pos telemetry.Position) telemetry.Position {
+	pos.Alt += s.elevation(pos.Lat, pos.Lon)
+	return pos
+}
+
+// groundEnemies keeps enemies on the terrain, enemy drones above it.
+func (s *Simulator) groundEnemies() {
+	if s.terrain == nil || s.enemyEng == nil {
+		return
+	}
+	for _, en := range s.enemyEng.Enemies {
+		ground := s.elevation(en.Position.Lat, en.Position.Lon)
+		if en.Type == enemy.EnemyDrone {
+			en.Position.Alt = math.Max(en.Position.Alt, ground)
+		} else {
+			en.Position.Alt = ground
+		}
+	}
+}
+
+// inSight reports whether a drone at from sees a target at to over the
+// terrain. Without a terrain model every target is in sight and detections
+// are degraded by the terrain occlusion factor instead.
+func (s *Simulator) inSight(from, to telemetry.Position) bool {
+	if s.terrain == nil {
+		return true
+	}
+	return s.terrain.LineOfSight(from.Lat, from.Lon, from.Alt, to.Lat, to.Lon, to.Alt+targetHeightM)
+}
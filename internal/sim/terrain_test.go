@@ -0,0 +1,97 @@
+package sim
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"testing"
+	"time"
+
+	"droneops-sim/internal/config"
+	"droneops-sim/internal/enemy"
+	"droneops-sim/internal/telemetry"
+	"droneops-sim/internal/terrain"
+)
+
+// ridge is a flat plain with a 300 m ridge running north-south at lon 0.01.
+func ridge() *terrain.Grid {
+	g := &terrain.Grid{Cols: 21, Rows: 3, North: 0.001, CellLon: 0.001, CellLat: 0.001, Heights: make([]float64, 63)}
+	for r := 0; r < g.Rows; r++ {
+		g.Heights[r*g.Cols+10] = 300
+	}
+	return g
+}
+
+func TestTerrainLaunchAndLineOfSight(t *testing.T) {
+	cfg := &config.SimulationConfig{
+		Zones: []config.Region{{Name: "ridge", CenterLon: 0.01, RadiusKM: 1}},
+		Fleets: []config.Fleet{
+			{Name: "f", Model: "small-fpv", Count: 1, MovementPattern: "patrol", HomeRegion: "ridge", AltitudeMode: telemetry.AltitudeTerrainFollowing},
+		},
+		DetectionRadiusM: 3000,
+		TerrainOcclusion: 1,
+		FollowConfidence: 101,
+		Terrain:          config.Terrain{DEM: "ridge.asc", Grid: ridge()},
+	}
+	sim := NewSimulator("c", cfg, &MockWriter{}, &MockDetectionWriter{}, time.Second, rand.New(rand.NewSource(1)), nil)
+	drone := sim.fleets[0].Drones[0]
+	if math.Abs(drone.Position.Alt-400) > 1e-6 || math.Abs(drone.AGL()-100) > 1e-6 {
+		t.Fatalf("expected the drone launched 100 m above the ridge, got alt %v agl %v", drone.Position.Alt, drone.AGL())
+	}
+	if c := sim.detectionConfidence(telemetry.Position{}, 1500); c != 50 {
+		t.Fatalf("expected terrain occlusion ignored with a terrain model, got %.2f", c)
+	}
+
+	en := &enemy.Enemy{ID: "e1", Type: enemy.EnemyVehicle, Position: telemetry.Position{Lon: 0.018, Alt: 50}, Status: enemy.EnemyActive}
+	sim.enemyEng = &enemy.Engine{Enemies: []*enemy.Enemy{en}}
+	sim.groundEnemies()
+	if en.Position.Alt != 0 {
+		t.Fatalf("expected the vehicle on the plain, got alt %v", en.Position.Alt)
+	}
+	drone.Position = telemetry.Position{Alt: 100}
+	if dets := sim.processDetections(&sim.fleets[0], drone); len(dets) != 0 {
+		t.Fatalf("expected the ridge to hide the enemy, got %+v", dets)
+	}
+	drone.Position.Alt = 1000
+	if dets := sim.processDetections(&sim.fleets[0], drone); len(dets) != 1 {
+		t.Fatalf("expected the enemy in sight over the ridge, got %d detections", len(dets))
+	}
+}
+
+func TestPlateauDefaultsToTerrainFollowing(t *testing.T) {
+	// A 300 m plateau 4.5 km across, higher than the default cruise altitude
+	plateau := &terrain.Grid{Cols: 41, Rows: 41, North: 0.02, CellLon: 0.001, CellLat: 0.001, Heights: make([]float64, 41*41)}
+	for i := range plateau.Heights {
+		plateau.Heights[i] = 300
+	}
+	cfg := &config.SimulationConfig{
+		Zones: []config.Region{{Name: "plateau", CenterLon: 0.02, RadiusKM: 1}},
+		Fleets: []config.Fleet{
+			{Name: "p", Model: "small-fpv", Count: 2, MovementPattern: "patrol", HomeRegion: "plateau"},
+			{Name: "b", Model: "small-fpv", Count: 1, MovementPattern: "patrol", HomeRegion: "plateau", Base: &config.Waypoint{Lon: 0.015}},
+		},
+		Terrain: config.Terrain{DEM: "plateau.asc", Grid: plateau},
+	}
+	sim := NewSimulator("c", cfg, &MockWriter{}, &MockDetectionWriter{}, time.Second, rand.New(rand.NewSource(1)), nil)
+	for _, d := range sim.fleets[0].Drones {
+		if d.AltitudeMode != telemetry.AltitudeTerrainFollowing || math.Abs(d.AGL()-telemetry.DefaultCruiseAltM) > 1e-6 {
+			t.Fatalf("expected %s on station the cruise height above the plateau, got mode %q agl %v", d.ID, d.AltitudeMode, d.AGL())
+		}
+	}
+	launched := sim.fleets[1].Drones[0]
+	launched.Phase = telemetry.PhaseTakeoff
+	sim.tick(context.Background())
+	if launched.Phase != telemetry.PhaseTakeoff {
+		t.Fatalf("expected the takeoff to climb above the plateau, got phase %s at agl %v", launched.Phase, launched.AGL())
+	}
+	for i := 0; i < 120; i++ {
+		sim.tick(context.Background())
+	}
+	for _, f := range sim.fleets {
+		for _, d := range f.Drones {
+			if !d.Airborne() || math.Abs(d.AGL()-telemetry.DefaultCruiseAltM) > 10 {
+				t.Fatalf("expected %s airborne at cruise height, got phase %s agl %v", d.ID, d.Phase, d.AGL())
+			}
+		}
+	}
+}
@@ -5,7 +5,9 @@ import (
 	"time"
 
 	"droneops-sim/internal/enemy"
+	"droneops-sim/internal/geo"
 	"droneops-sim/internal/logging"
+	"droneops-sim/internal/poi"
 	"droneops-sim/internal/telemetry"
 )
 
@@ -32,6 +34,8 @@ func (s *Simulator) tick(ctx context.Context) {
 	log := logging.FromContext(ctx)
 	var batch []telemetry.TelemetryRow
 	var detections []enemy.DetectionRow
+	var poiDetections []poi.DetectionRow
+	var breaches []telemetry.GeofenceBreachRow
 
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -46,13 +50,24 @@ func (s *Simulator) tick(ctx context.Context) {
 		for _, en := range s.enemyEng.Enemies {
 			s.enemyPrevPositions[en.ID] = en.Position
 		}
+		s.updateEnemyTargets()
 		removed := s.enemyEng.Step(allDrones)
 		for _, id := range removed {
 			s.removeEnemy(id)
 		}
+		s.groundEnemies()
 	}
+	convoyRows := s.stepConvoys()
+	s.stepWeather()
+	s.assignEscortSlots()
+	s.assignFormationSlots()
+	s.chargeDrones(s.tickInterval)
+	s.rotateFleets()
+	wind := s.updateWind(allDrones)
+	s.updateAirspace(allDrones)
 
-	for _, fleet := range s.fleets {
+	for i := range s.fleets {
+		fleet := &s.fleets[i]
 		for _, drone := range fleet.Drones {
 			row, ok := s.updateDrone(drone)
 			if !ok {
@@ -61,16 +76,22 @@ func (s *Simulator) tick(ctx context.Context) {
 			if s.chaosMode {
 				s.injectChaos(drone, &row)
 			}
+			breaches = append(breaches, s.checkGeofence(drone, &row)...)
 			if s.enableMovement {
 				batch = append(batch, row)
 			}
 			if s.enableDetections {
-				detections = append(detections, s.processDetections(&fleet, drone)...)
+				detections = append(detections, s.processDetections(fleet, drone)...)
+				poiDetections = append(poiDetections, s.processPOIDetections(drone)...)
 			}
 		}
 	}
 
+	s.checkSeparation()
 	s.reassignFollowers()
+	s.deliverPOIs()
+	assetRows := s.damageAssets()
+	s.advanceScenario()
 
 	// Batch support if writer implements WriteBatch
 	if s.enableMovement {
@@ -102,6 +123,14 @@ func (s *Simulator) tick(ctx context.Context) {
 		}
 	}
 
+	if s.enableDetections {
+		s.writePOIDetections(poiDetections)
+	}
+	s.writeConvoys(convoyRows)
+	s.writeAssets(assetRows)
+	s.writeGeofenceBreaches(breaches)
+	s.writeWeather()
+
 	// Emit simulation state metrics
 	if s.enableSimulationState {
 		if sw, ok := s.writer.(StateWriter); ok {
@@ -111,7 +140,10 @@ func (s *Simulator) tick(ctx context.Context) {
 				MessagesSent:      s.messagesSent,
 				SensorNoise:       s.sensorNoise,
 				WeatherImpact:     s.weatherImpact,
+				WindDirectionDeg:  wind.DirectionDeg,
+				WindSpeedMPS:      wind.SpeedMPS,
 				ChaosMode:         s.chaosMode,
+				Coverage:          s.coverageStats(),
 				Timestamp:         s.now().UTC(),
 			}
 			if bw, ok := s.writer.(batchStateWriter); ok {
@@ -128,7 +160,7 @@ func (s *Simulator) tick(ctx context.Context) {
 }
 
 func (s *Simulator) updateDrone(drone *telemetry.Drone) (telemetry.TelemetryRow, bool) {
-	if drone.FollowTarget != nil && (s.rand.Float64() < s.commLoss || drone.Status == telemetry.StatusFailure) {
+	if drone.FollowTarget != nil && (s.rand.Float64() < s.conditionsAt(drone.Position).CommLoss || drone.Status == telemetry.StatusFailure) {
 		s.removeAssignment(drone)
 	}
 	prev, ok := s.dronePrevPositions[drone.ID]
@@ -136,12 +168,17 @@ func (s *Simulator) updateDrone(drone *telemetry.Drone) (telemetry.TelemetryRow,
 		prev = drone.Position
 	}
 	row := s.teleGen.GenerateTelemetry(drone, prev, s.tickInterval)
+	s.separate(drone)
+	row.Lat, row.Lon = drone.Position.Lat, drone.Position.Lon
+	row.AltAGL = drone.AGL()
+	s.markCoverage(drone, prev)
 	s.dronePrevPositions[drone.ID] = drone.Position
-	if s.rand.Float64() < drone.SensorErrorRate {
-		row.Lat += s.rand.Float64()*sensorErrorMaxOffset*2 - sensorErrorMaxOffset
-		row.Lon += s.rand.Float64()*sensorErrorMaxOffset*2 - sensorErrorMaxOffset
+	if s.rand.Float64() < drone.Behavior.SensorErrorRate {
+		north := s.rand.Float64()*sensorErrorMaxOffset*2 - sensorErrorMaxOffset
+		east := s.rand.Float64()*sensorErrorMaxOffset*2 - sensorErrorMaxOffset
+		row.Lat, row.Lon = geo.Offset(row.Lat, row.Lon, north, east)
 	}
-	if s.rand.Float64() < drone.BatteryAnomalyRate {
+	if s.rand.Float64() < drone.Behavior.BatteryAnomalyRate {
 		drop := s.rand.Float64()*20 + 10
 		drone.Battery -= drop
 		if drone.Battery < 0 {
@@ -150,12 +187,12 @@ func (s *Simulator) updateDrone(drone *telemetry.Drone) (telemetry.TelemetryRow,
 		row.Battery = drone.Battery
 	}
 	if s.tickInterval > 0 {
-		row.SpeedMPS = distanceMeters(prev.Lat, prev.Lon, row.Lat, row.Lon) / s.tickInterval.Seconds()
-		row.HeadingDeg = bearingDegrees(prev.Lat, prev.Lon, row.Lat, row.Lon)
+		row.SpeedMPS = geo.Distance(prev.Lat, prev.Lon, row.Lat, row.Lon) / s.tickInterval.Seconds()
+		row.HeadingDeg = geo.Bearing(prev.Lat, prev.Lon, row.Lat, row.Lon)
 	}
 	row.PreviousPosition = prev
 	row.MovementPattern = drone.MovementPattern
-	if s.rand.Float64() < drone.DropoutRate {
+	if s.rand.Float64() < drone.Behavior.DropoutRate {
 		return telemetry.TelemetryRow{}, false
 	}
 	return row, true
@@ -180,26 +217,16 @@ func (s *Simulator) processDetections(fleet *DroneFleet, drone *telemetry.Drone)
 	}
 	var detections []enemy.DetectionRow
 	for _, en := range s.enemyEng.Enemies {
-		dist := distanceMeters(drone.Position.Lat, drone.Position.Lon, en.Position.Lat, en.Position.Lon)
-		if dist > s.detectionRadiusM {
+		dist := geo.Distance(drone.Position.Lat, drone.Position.Lon, en.Position.Lat, en.Position.Lon)
+		if dist > s.detectionRadiusM || !s.inSight(drone.Position, en.Position) {
 			continue
 		}
-		conf := 100 * (1 - dist/s.detectionRadiusM)
-		conf *= 1 - s.terrainOcclusion
-		conf *= 1 - s.weatherImpact
-		if s.sensorNoise > 0 {
-			conf += s.rand.NormFloat64() * s.sensorNoise * conf
-		}
-		if conf < 0 {
-			conf = 0
-		} else if conf > 100 {
-			conf = 100
-		}
+		conf := s.detectionConfidence(drone.Position, dist)
 		var vel float64
 		if prev, ok := s.enemyPrevPositions[en.ID]; ok && s.tickInterval > 0 {
-			vel = distanceMeters(prev.Lat, prev.Lon, en.Position.Lat, en.Position.Lon) / s.tickInterval.Seconds()
+			vel = geo.Distance(prev.Lat, prev.Lon, en.Position.Lat, en.Position.Lon) / s.tickInterval.Seconds()
 		}
-		bearing := bearingDegrees(drone.Position.Lat, drone.Position.Lon, en.Position.Lat, en.Position.Lon)
+		bearing := geo.Bearing(drone.Position.Lat, drone.Position.Lon, en.Position.Lat, en.Position.Lon)
 		d := enemy.DetectionRow{
 			ClusterID:  s.clusterID,
 			DroneID:    drone.ID,
@@ -224,3 +251,24 @@ func (s *Simulator) processDetections(fleet *DroneFleet, drone *telemetry.Drone)
 	}
 	return detections
 }
+
+// detectionConfidence converts a distance within the detection radius into a
+// confidence between 0 and 100, degraded by the weather at the drone's
+// position, sensor noise and, without a terrain model to check the line of
+// sight, the terrain occlusion factor.
+func (s *Simulator) detectionConfidence(pos telemetry.Position, dist float64) float64 {
+	conf := 100 * (1 - dist/s.detectionRadiusM)
+	if s.terrain == nil {
+		conf *= 1 - s.terrainOcclusion
+	}
+	conf *= 1 - s.conditionsAt(pos).SensorImpact
+	if s.sensorNoise > 0 {
+		conf += s.rand.NormFloat64() * s.sensorNoise * conf
+	}
+	if conf < 0 {
+		conf = 0
+	} else if conf > 100 {
+		conf = 100
+	}
+	return conf
+}
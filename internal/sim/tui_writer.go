@@ -17,9 +17,13 @@ import (
 	"github.com/google/uuid"
 	"github.com/muesli/reflow/wordwrap"
 
+	"droneops-sim/internal/asset"
 	"droneops-sim/internal/config"
 	"droneops-sim/internal/enemy"
+	"droneops-sim/internal/geo"
+	"droneops-sim/internal/poi"
 	"droneops-sim/internal/telemetry"
+	"droneops-sim/internal/weather"
 )
 
 // teaProgram abstracts bubbletea.Program for testing.
@@ -36,6 +40,18 @@ type detectionMsg struct {
 	row  enemy.DetectionRow
 }
 
+// poiMsg carries a point of interest detection log line and row data.
+type poiMsg struct {
+	line string
+	row  poi.DetectionRow
+}
+
+// assetMsg carries the latest state of a fixed asset.
+type assetMsg struct{ row asset.StateRow }
+
+// weatherMsg carries the current weather cells.
+type weatherMsg struct{ cells []MapWeatherCell }
+
 // swarmMsg carries a swarm event log line.
 type swarmMsg struct{ line string }
 
@@ -45,6 +61,9 @@ type stateMsg struct{ telemetry.SimulationStateRow }
 // adminMsg reports admin UI status.
 type adminMsg struct{ active bool }
 
+// scenarioMsg reports the active scenario phase.
+type scenarioMsg struct{ name, phase string }
+
 type setSpawnMsg struct{ fn func(enemy.Enemy) }
 type setRemoveMsg struct{ fn func(string) }
 type setStatusMsg struct {
@@ -181,14 +200,58 @@ func (w *TUIWriter) WriteDetection(d enemy.DetectionRow) error {
 	return nil
 }
 
+// WritePOIDetection implements POIDetectionWriter.
+func (w *TUIWriter) WritePOIDetection(d poi.DetectionRow) error {
+	line := fmt.Sprintf("%s[%s]%s %sPOI%s %sdrone=%s%s %spoi=%s%s %stype=%s%s %sstatus=%s%s %sconf=%.2f%s",
+		colorGray, d.Timestamp.Format(time.RFC3339), colorReset,
+		colorGreen, colorReset,
+		colorWhite(), d.DroneID, colorReset,
+		colorBlue, d.POIID, colorReset,
+		colorMagenta, d.POIType, colorReset,
+		poiStatusColor(d.Status), d.Status, colorReset,
+		colorGreen, d.Confidence, colorReset)
+	w.program.Send(poiMsg{line: line, row: d})
+	return nil
+}
+
+// WritePOIDetections outputs multiple point of interest detections.
+func (w *TUIWriter) WritePOIDetections(rows []poi.DetectionRow) error {
+	for _, d := range rows {
+		_ = w.WritePOIDetection(d)
+	}
+	return nil
+}
+
+// WriteAsset implements AssetWriter by updating the asset shown on the map.
+func (w *TUIWriter) WriteAsset(row asset.StateRow) error {
+	w.program.Send(assetMsg{row: row})
+	return nil
+}
+
+// WriteAssets updates multiple fixed assets.
+func (w *TUIWriter) WriteAssets(rows []asset.StateRow) error {
+	for _, r := range rows {
+		_ = w.WriteAsset(r)
+	}
+	return nil
+}
+
+// WriteWeather implements WeatherWriter by updating the weather cells shown on the map.
+func (w *TUIWriter) WriteWeather(cells []MapWeatherCell) error {
+	w.program.Send(weatherMsg{cells: cells})
+	return nil
+}
+
 // WriteSwarmEvent implements SwarmEventWriter.
 func (w *TUIWriter) WriteSwarmEvent(e telemetry.SwarmEventRow) error {
 	evtColor := colorBlue
 	switch e.EventType {
 	case telemetry.SwarmEventAssignment:
 		evtColor = colorGreen
-	case telemetry.SwarmEventUnassignment:
+	case telemetry.SwarmEventUnassignment, telemetry.SwarmEventCollision:
 		evtColor = colorRed
+	case telemetry.SwarmEventNearMiss:
+		evtColor = colorYellow
 	}
 	line := fmt.Sprintf("%s[%s]%s %sSWARM%s %stype=%s%s %sdrones=%v%s",
 		colorGray, e.Timestamp.Format(time.RFC3339), colorReset,
@@ -198,10 +261,42 @@ func (w *TUIWriter) WriteSwarmEvent(e telemetry.SwarmEventRow) error {
 	if e.EnemyID != "" {
 		line += fmt.Sprintf(" %senemy=%s%s", colorMagenta, e.EnemyID, colorReset)
 	}
+	if e.Formation != "" {
+		line += fmt.Sprintf(" %sformation=%s leader=%s%s", colorYellow, e.Formation, e.LeaderID, colorReset)
+	}
+	if e.DistanceM > 0 {
+		line += fmt.Sprintf(" %sdistance=%.1fm%s", colorYellow, e.DistanceM, colorReset)
+	}
 	w.program.Send(swarmMsg{line: line})
 	return nil
 }
 
+// WriteGeofenceBreach implements GeofenceWriter by logging the breach with
+// the swarm events.
+func (w *TUIWriter) WriteGeofenceBreach(r telemetry.GeofenceBreachRow) error {
+	evtColor := colorRed
+	if r.Event == telemetry.GeofenceExit {
+		evtColor = colorGreen
+	}
+	line := fmt.Sprintf("%s[%s]%s %sGEOFENCE%s %s%s%s %sdrone=%s%s %szone=%s%s %sdepth=%.1fm%s",
+		colorGray, r.Timestamp.Format(time.RFC3339), colorReset,
+		colorRed, colorReset,
+		evtColor, r.Event, colorReset,
+		colorWhite(), r.DroneID, colorReset,
+		colorMagenta, r.ZoneID, colorReset,
+		colorYellow, r.PenetrationM, colorReset)
+	w.program.Send(swarmMsg{line: line})
+	return nil
+}
+
+// WriteGeofenceBreaches outputs multiple geofence breaches.
+func (w *TUIWriter) WriteGeofenceBreaches(rows []telemetry.GeofenceBreachRow) error {
+	for _, r := range rows {
+		_ = w.WriteGeofenceBreach(r)
+	}
+	return nil
+}
+
 // WriteState implements StateWriter.
 func (w *TUIWriter) WriteState(row telemetry.SimulationStateRow) error {
 	w.program.Send(stateMsg{SimulationStateRow: row})
@@ -245,6 +340,11 @@ func (w *TUIWriter) SetAdminStatus(active bool) {
 	w.program.Send(adminMsg{active: active})
 }
 
+// SetScenarioPhase updates the scenario phase shown in the header.
+func (w *TUIWriter) SetScenarioPhase(name, phase string) {
+	w.program.Send(scenarioMsg{name: name, phase: phase})
+}
+
 // SetSpawner registers a callback to spawn enemies.
 func (w *TUIWriter) SetSpawner(fn func(enemy.Enemy)) {
 	w.program.Send(setSpawnMsg{fn: fn})
@@ -284,6 +384,8 @@ type tuiModel struct {
 	lastSwarm        string
 	state            telemetry.SimulationStateRow
 	admin            bool
+	scenarioName     string
+	scenarioPhase    string
 	wrap             bool
 	autoscroll       bool
 	header           string
@@ -291,6 +393,9 @@ type tuiModel struct {
 	height           int
 	missionColors    map[string]string
 	enemies          []enemy.Enemy
+	pois             []poi.POI
+	assets           []asset.Asset
+	weather          []MapWeatherCell
 	spawn            func(enemy.Enemy)
 	enemyInput       textinput.Model
 	enemyDialog      bool
@@ -318,6 +423,9 @@ type tuiModel struct {
 	mapShowZones     bool
 	mapShowDetection bool
 	mapShowTrails    bool
+	mapShowPOIs      bool
+	mapShowAssets    bool
+	mapShowWeather   bool
 	droneBatteries   map[string]float64
 	missionTotals    map[string]int
 	missionCounts    map[string]map[string]struct{}
@@ -364,6 +472,9 @@ func newTUIModel(cfg *config.SimulationConfig, missionColors map[string]string,
 		mapShowZones:     true,
 		mapShowDetection: false,
 		mapShowTrails:    true,
+		mapShowPOIs:      true,
+		mapShowAssets:    true,
+		mapShowWeather:   true,
 		dronePositions:   make(map[string]telemetry.Position),
 		droneHeadings:    make(map[string]float64),
 		droneTrails:      make(map[string][]telemetry.Position),
@@ -374,6 +485,33 @@ func newTUIModel(cfg *config.SimulationConfig, missionColors map[string]string,
 		summary:          true,
 		symbols:          symbols,
 	}
+	for _, p := range cfg.PointsOfInterest {
+		m.pois = append(m.pois, poi.POI{
+			ID:       p.ID,
+			Type:     poi.Type(p.Type),
+			Position: telemetry.Position{Lat: p.Lat, Lon: p.Lon, Alt: p.Alt},
+			Status:   poi.StatusMissing,
+		})
+	}
+	for _, a := range cfg.FixedAssets {
+		m.assets = append(m.assets, asset.Asset{
+			ID:       a.ID,
+			Owner:    a.Owner,
+			Position: telemetry.Position{Lat: a.Lat, Lon: a.Lon, Alt: a.Alt},
+			RadiusM:  a.RadiusM,
+			Status:   asset.StatusOperational,
+		})
+	}
+	for _, c := range cfg.WeatherCells {
+		m.weather = append(m.weather, MapWeatherCell{
+			ID:        c.ID,
+			Type:      weather.Type(c.Type),
+			Lat:       c.Lat,
+			Lon:       c.Lon,
+			RadiusM:   c.RadiusKM * 1000,
+			Intensity: c.Intensity,
+		})
+	}
 	return m
 }
 
@@ -514,6 +652,15 @@ func (m tuiModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			case "5":
 				m.mapShowTrails = !m.mapShowTrails
 				return m, nil
+			case "6":
+				m.mapShowPOIs = !m.mapShowPOIs
+				return m, nil
+			case "7":
+				m.mapShowAssets = !m.mapShowAssets
+				return m, nil
+			case "8":
+				m.mapShowWeather = !m.mapShowWeather
+				return m, nil
 			}
 		}
 		switch msg.String() {
@@ -654,6 +801,17 @@ func (m tuiModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.updateViewportHeight()
 		m.refreshDetections()
 		m.refreshViewport()
+	case poiMsg:
+		m.detLogs = append(m.detLogs, msg.line)
+		if len(m.detLogs) > 1000 {
+			m.detLogs = m.detLogs[len(m.detLogs)-1000:]
+		}
+		m.updatePOI(msg.row)
+		m.refreshDetections()
+	case assetMsg:
+		m.updateAsset(msg.row)
+	case weatherMsg:
+		m.weather = msg.cells
 	case swarmMsg:
 		m.swarmLogs = append(m.swarmLogs, msg.line)
 		if len(m.swarmLogs) > 1000 {
@@ -697,6 +855,12 @@ func (m tuiModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.state = msg.SimulationStateRow
 	case adminMsg:
 		m.admin = msg.active
+	case scenarioMsg:
+		m.scenarioName = msg.name
+		m.scenarioPhase = msg.phase
+		m.header = m.renderHeader()
+		m.headerHeight = lipgloss.Height(m.header)
+		m.updateViewportHeight()
 	case setSpawnMsg:
 		m.spawn = msg.fn
 	case setRemoveMsg:
@@ -829,14 +993,17 @@ func (m tuiModel) View() string {
 }
 
 func (m tuiModel) renderHeader() string {
-	tableView := m.table.View()
-	if !m.showMissions {
-		return tableView
+	header := m.table.View()
+	if m.showMissions {
+		missionsWidth := m.vp.Width/2 - 1
+		missions := renderMissionTree(m.cfg, m.missionColors, m.wrap, missionsWidth)
+		sep := lipgloss.NewStyle().Foreground(lipgloss.Color("8")).Render("│")
+		header = lipgloss.JoinHorizontal(lipgloss.Top, header, sep, missions)
 	}
-	missionsWidth := m.vp.Width/2 - 1
-	missions := renderMissionTree(m.cfg, m.missionColors, m.wrap, missionsWidth)
-	sep := lipgloss.NewStyle().Foreground(lipgloss.Color("8")).Render("│")
-	return lipgloss.JoinHorizontal(lipgloss.Top, tableView, sep, missions)
+	if m.scenarioPhase != "" {
+		header = fmt.Sprintf("%sSCENARIO%s %s %sphase=%s%s\n%s", colorBlue, colorReset, m.scenarioName, colorYellow, m.scenarioPhase, colorReset, header)
+	}
+	return header
 }
 
 func renderMissionTree(cfg *config.SimulationConfig, colors map[string]string, wrap bool, width int) string {
@@ -940,13 +1107,17 @@ func (m tuiModel) renderBottom() string {
 		enemiesColor = lipgloss.Color("9")
 	}
 	enemiesIndicator := lipgloss.NewStyle().Foreground(enemiesColor).Render("●")
-	state := fmt.Sprintf("%sSTATE%s %scomm_loss=%.2f%s %smsgs=%d%s %ssensor=%.2f%s %sweather=%.2f%s %schaos=%t%s",
+	state := fmt.Sprintf("%sSTATE%s %scomm_loss=%.2f%s %smsgs=%d%s %ssensor=%.2f%s %sweather=%.2f%s %swind=%.0f°@%.1fm/s%s %schaos=%t%s",
 		colorBlue, colorReset,
 		colorYellow, m.state.CommunicationLoss, colorReset,
 		colorGreen, m.state.MessagesSent, colorReset,
 		colorMagenta, m.state.SensorNoise, colorReset,
 		colorCyan, m.state.WeatherImpact, colorReset,
+		colorCyan, m.state.WindDirectionDeg, m.state.WindSpeedMPS, colorReset,
 		colorRed, m.state.ChaosMode, colorReset)
+	for _, c := range m.state.Coverage {
+		state += fmt.Sprintf(" %scoverage[%s]=%.1f%%%s", colorGreen, c.MissionID, c.CoveragePct, colorReset)
+	}
 	helpHint := fmt.Sprintf("%s(h)elp%s", colorBlue, colorReset)
 	line := fmt.Sprintf("%s | Admin UI %s | Wrap %s | Scroll %s | Summary %s | Missions %s | Enemies %s | %s", state, adminIndicator, wrapIndicator, scrollIndicator, summaryIndicator, missionsIndicator, enemiesIndicator, helpHint)
 	if m.summary {
@@ -973,6 +1144,9 @@ func (m tuiModel) renderHelp() string {
 		" 3  toggle mission zones",
 		" 4  toggle detection radius",
 		" 5  toggle trails",
+		" 6  toggle points of interest",
+		" 7  toggle fixed assets",
+		" 8  toggle weather cells",
 		" p  toggle mission tree",
 		" n  toggle enemies section",
 		" h/? toggle this help view",
@@ -1085,11 +1259,50 @@ func (m *tuiModel) initMapViewport() {
 			maxLon = p.Lon
 		}
 	}
+	for _, p := range m.pois {
+		if p.Position.Lat < minLat {
+			minLat = p.Position.Lat
+		}
+		if p.Position.Lat > maxLat {
+			maxLat = p.Position.Lat
+		}
+		if p.Position.Lon < minLon {
+			minLon = p.Position.Lon
+		}
+		if p.Position.Lon > maxLon {
+			maxLon = p.Position.Lon
+		}
+	}
+	for _, a := range m.assets {
+		if a.Position.Lat < minLat {
+			minLat = a.Position.Lat
+		}
+		if a.Position.Lat > maxLat {
+			maxLat = a.Position.Lat
+		}
+		if a.Position.Lon < minLon {
+			minLon = a.Position.Lon
+		}
+		if a.Position.Lon > maxLon {
+			maxLon = a.Position.Lon
+		}
+	}
+	for _, c := range m.weather {
+		if c.Lat < minLat {
+			minLat = c.Lat
+		}
+		if c.Lat > maxLat {
+			maxLat = c.Lat
+		}
+		if c.Lon < minLon {
+			minLon = c.Lon
+		}
+		if c.Lon > maxLon {
+			maxLon = c.Lon
+		}
+	}
 	for _, ms := range m.cfg.Missions {
-		kmPerLat := 111.0
-		kmPerLon := 111.0 * math.Cos(ms.Region.CenterLat*math.Pi/180)
-		latDelta := ms.Region.RadiusKM / kmPerLat
-		lonDelta := ms.Region.RadiusKM / kmPerLon
+		latDelta, lonDelta := degreeSpan(ms.Region.CenterLat, ms.Region.CenterLon, ms.Region.RadiusKM*1000)
 		if ms.Region.CenterLat-latDelta < minLat {
 			minLat = ms.Region.CenterLat - latDelta
 		}
@@ -1120,6 +1333,14 @@ func (m *tuiModel) initMapViewport() {
 	m.mapInitialized = true
 }
 
+// degreeSpan returns the latitude and longitude extent in degrees of a
+// radius of radiusM meters around lat, lon.
+func degreeSpan(lat, lon, radiusM float64) (dLat, dLon float64) {
+	nLat, _ := geo.Offset(lat, lon, radiusM, 0)
+	_, eLon := geo.Offset(lat, lon, 0, radiusM)
+	return nLat - lat, geo.NormalizeLon(eLon - lon)
+}
+
 func (m tuiModel) renderMap() string {
 	width := m.vp.Width - 2
 	if width < 1 {
@@ -1130,7 +1351,7 @@ func (m tuiModel) renderMap() string {
 	if mapHeight < 1 {
 		mapHeight = 1
 	}
-	if len(m.dronePositions) == 0 && len(m.enemies) == 0 && len(m.cfg.Missions) == 0 {
+	if len(m.dronePositions) == 0 && len(m.enemies) == 0 && len(m.pois) == 0 && len(m.assets) == 0 && len(m.weather) == 0 && len(m.cfg.Missions) == 0 {
 		return "No position data"
 	}
 	minLat := m.mapCenterLat - m.mapLatSpan/2
@@ -1172,12 +1393,29 @@ func (m tuiModel) renderMap() string {
 			if col, ok := m.missionColors[ms.ID]; ok {
 				c = col
 			}
+			if ms.Region.Shape != nil {
+				// Trace the polygon edges, a few marks per edge
+				for _, p := range ms.Region.Shape {
+					for _, r := range p {
+						for i := range r {
+							a, b := r[i], r[(i+1)%len(r)]
+							for k := 0; k < 8; k++ {
+								f := float64(k) / 8
+								lon := a.Lon + geo.NormalizeLon(b.Lon-a.Lon)*f
+								x := int((lon - minLon) / (maxLon - minLon) * float64(width-1))
+								y := int((maxLat - (a.Lat + (b.Lat-a.Lat)*f)) / (maxLat - minLat) * float64(mapHeight-1))
+								if y >= 0 && y < mapHeight && x >= 0 && x < width {
+									grid[y][x] = fmt.Sprintf("%s%s%s", c, "◯", colorReset)
+								}
+							}
+						}
+					}
+				}
+				continue
+			}
 			x0 := int((ms.Region.CenterLon - minLon) / (maxLon - minLon) * float64(width-1))
 			y0 := int((maxLat - ms.Region.CenterLat) / (maxLat - minLat) * float64(mapHeight-1))
-			kmPerLat := 111.0
-			kmPerLon := 111.0 * math.Cos(ms.Region.CenterLat*math.Pi/180)
-			rLat := ms.Region.RadiusKM / kmPerLat
-			rLon := ms.Region.RadiusKM / kmPerLon
+			rLat, rLon := degreeSpan(ms.Region.CenterLat, ms.Region.CenterLon, ms.Region.RadiusKM*1000)
 			rx := rLon / (maxLon - minLon) * float64(width-1)
 			ry := rLat / (maxLat - minLat) * float64(mapHeight-1)
 			for deg := 0; deg < 360; deg += 10 {
@@ -1190,13 +1428,30 @@ func (m tuiModel) renderMap() string {
 			}
 		}
 	}
+	if m.mapShowWeather {
+		for _, c := range m.weather {
+			sym := weatherSymbol(c.Type)
+			x0 := int((c.Lon - minLon) / (maxLon - minLon) * float64(width-1))
+			y0 := int((maxLat - c.Lat) / (maxLat - minLat) * float64(mapHeight-1))
+			rLat, rLon := degreeSpan(c.Lat, c.Lon, c.RadiusM)
+			rx := rLon / (maxLon - minLon) * float64(width-1)
+			ry := rLat / (maxLat - minLat) * float64(mapHeight-1)
+			for deg := 0; deg < 360; deg += 10 {
+				rad := float64(deg) * math.Pi / 180
+				x := int(float64(x0) + math.Cos(rad)*rx)
+				y := int(float64(y0) + math.Sin(rad)*ry)
+				if y >= 0 && y < mapHeight && x >= 0 && x < width {
+					grid[y][x] = sym
+				}
+			}
+			if y0 >= 0 && y0 < mapHeight && x0 >= 0 && x0 < width {
+				grid[y0][x0] = sym
+			}
+		}
+	}
 	if m.mapShowDetection && m.cfg.DetectionRadiusM > 0 {
-		radiusKM := m.cfg.DetectionRadiusM / 1000
 		for _, p := range m.dronePositions {
-			kmPerLat := 111.0
-			kmPerLon := 111.0 * math.Cos(p.Lat*math.Pi/180)
-			rLat := radiusKM / kmPerLat
-			rLon := radiusKM / kmPerLon
+			rLat, rLon := degreeSpan(p.Lat, p.Lon, m.cfg.DetectionRadiusM)
 			rx := rLon / (maxLon - minLon) * float64(width-1)
 			ry := rLat / (maxLat - minLat) * float64(mapHeight-1)
 			x0 := int((p.Lon - minLon) / (maxLon - minLon) * float64(width-1))
@@ -1246,6 +1501,24 @@ func (m tuiModel) renderMap() string {
 			}
 		}
 	}
+	if m.mapShowPOIs {
+		for _, p := range m.pois {
+			x := int((p.Position.Lon - minLon) / (maxLon - minLon) * float64(width-1))
+			y := int((maxLat - p.Position.Lat) / (maxLat - minLat) * float64(mapHeight-1))
+			if y >= 0 && y < mapHeight && x >= 0 && x < width {
+				grid[y][x] = fmt.Sprintf("%s%s%s", poiStatusColor(p.Status), poiSymbol(p.Type), colorReset)
+			}
+		}
+	}
+	if m.mapShowAssets {
+		for _, a := range m.assets {
+			x := int((a.Position.Lon - minLon) / (maxLon - minLon) * float64(width-1))
+			y := int((maxLat - a.Position.Lat) / (maxLat - minLat) * float64(mapHeight-1))
+			if y >= 0 && y < mapHeight && x >= 0 && x < width {
+				grid[y][x] = fmt.Sprintf("%sA%s", assetStatusColor(a.Status), colorReset)
+			}
+		}
+	}
 	if m.mapShowDrones {
 		for id, p := range m.dronePositions {
 			x := int((p.Lon - minLon) / (maxLon - minLon) * float64(width-1))
@@ -1279,8 +1552,7 @@ func (m tuiModel) renderMap() string {
 	}
 	// simple horizontal scale bar based on longitude range
 	midLat := (maxLat + minLat) / 2
-	kmPerLon := 111.0 * math.Cos(midLat*math.Pi/180)
-	kmPerChar := lonRange * kmPerLon / float64(width)
+	kmPerChar := geo.Distance(midLat, minLon, midLat, minLon+lonRange) / 1000 / float64(width)
 	barChars := int(math.Min(10, float64(width)/3))
 	scaleKM := kmPerChar * float64(barChars)
 	b.WriteString(fmt.Sprintf("Scale: |%s| %.0fkm\n", strings.Repeat("-", barChars), scaleKM))
@@ -1301,6 +1573,11 @@ func (m tuiModel) renderMap() string {
 	legendParts = append(legendParts,
 		fmt.Sprintf("%sX%s=active", colorRed, colorReset),
 		fmt.Sprintf("%sx%s=neutralized", colorYellow, colorReset),
+		"S/W/C=survivor/wreckage/cache",
+		fmt.Sprintf("%s●%s=missing %s●%s=found %s●%s=extracted", poiStatusColor(poi.StatusMissing), colorReset, poiStatusColor(poi.StatusFound), colorReset, poiStatusColor(poi.StatusExtracted), colorReset),
+		"A=asset",
+		fmt.Sprintf("%s=storm %s=fog", weatherSymbol(weather.TypeStorm), weatherSymbol(weather.TypeFog)),
+		fmt.Sprintf("%s●%s=operational %s●%s=damaged %s●%s=destroyed", assetStatusColor(asset.StatusOperational), colorReset, assetStatusColor(asset.StatusDamaged), colorReset, assetStatusColor(asset.StatusDestroyed), colorReset),
 	)
 	b.WriteString(strings.Join(legendParts, " "))
 	content := strings.TrimRight(b.String(), "\n")
@@ -1308,6 +1585,78 @@ func (m tuiModel) renderMap() string {
 	return style.Render(content)
 }
 
+// updatePOI records the latest status and position reported for a point of interest.
+func (m *tuiModel) updatePOI(d poi.DetectionRow) {
+	pos := telemetry.Position{Lat: d.Lat, Lon: d.Lon, Alt: d.Alt}
+	for i := range m.pois {
+		if m.pois[i].ID == d.POIID {
+			m.pois[i].Status = d.Status
+			m.pois[i].Position = pos
+			return
+		}
+	}
+	m.pois = append(m.pois, poi.POI{ID: d.POIID, Type: d.POIType, Position: pos, Status: d.Status})
+}
+
+// updateAsset records the latest state reported for a fixed asset.
+func (m *tuiModel) updateAsset(r asset.StateRow) {
+	for i := range m.assets {
+		if m.assets[i].ID == r.AssetID {
+			m.assets[i].Status = r.Status
+			m.assets[i].HitPoints = r.HitPoints
+			return
+		}
+	}
+	m.assets = append(m.assets, asset.Asset{
+		ID:        r.AssetID,
+		Owner:     r.Owner,
+		Position:  telemetry.Position{Lat: r.Lat, Lon: r.Lon, Alt: r.Alt},
+		RadiusM:   r.RadiusM,
+		HitPoints: r.HitPoints,
+		Status:    r.Status,
+	})
+}
+
+// weatherSymbol returns the colored map symbol outlining a weather cell.
+func weatherSymbol(t weather.Type) string {
+	if t == weather.TypeFog {
+		return fmt.Sprintf("%s░%s", colorGray, colorReset)
+	}
+	return fmt.Sprintf("%s≈%s", colorMagenta, colorReset)
+}
+
+func assetStatusColor(st asset.Status) string {
+	switch st {
+	case asset.StatusDamaged:
+		return colorYellow
+	case asset.StatusDestroyed:
+		return colorRed
+	}
+	return colorGreen
+}
+
+func poiSymbol(t poi.Type) string {
+	switch t {
+	case poi.Survivor:
+		return "S"
+	case poi.Wreckage:
+		return "W"
+	case poi.Cache:
+		return "C"
+	}
+	return "?"
+}
+
+func poiStatusColor(st poi.Status) string {
+	switch st {
+	case poi.StatusFound:
+		return colorGreen
+	case poi.StatusExtracted:
+		return colorGray
+	}
+	return colorMagenta
+}
+
 func (m tuiModel) renderEnemies() string {
 	if m.enemyDialog {
 		return fmt.Sprintf("Spawn Enemy (type,lat,lon,alt) - Enter to spawn, Esc to cancel: %s", m.enemyInput.View())
@@ -8,9 +8,12 @@ import (
 
 	tea "github.com/charmbracelet/bubbletea"
 
+	"droneops-sim/internal/asset"
 	"droneops-sim/internal/config"
 	"droneops-sim/internal/enemy"
+	"droneops-sim/internal/poi"
 	"droneops-sim/internal/telemetry"
+	"droneops-sim/internal/weather"
 )
 
 type fakeProgram struct{ msgs []tea.Msg }
@@ -357,6 +360,22 @@ func TestWrapToggle(t *testing.T) {
 	}
 }
 
+func TestScenarioPhaseInHeader(t *testing.T) {
+	cfg := &config.SimulationConfig{}
+	m := newTUIModel(cfg, map[string]string{}, unicodeSymbols)
+	mi, _ := m.Update(tea.WindowSizeMsg{Width: 40, Height: 20})
+	m = mi.(tuiModel)
+	before := m.headerHeight
+	mi, _ = m.Update(scenarioMsg{name: "Escort", phase: "climax"})
+	m = mi.(tuiModel)
+	if !strings.Contains(m.header, "Escort") || !strings.Contains(m.header, "phase=climax") {
+		t.Fatalf("expected scenario phase in header: %q", m.header)
+	}
+	if m.headerHeight != before+1 {
+		t.Fatalf("expected header height to grow by one line, got %d -> %d", before, m.headerHeight)
+	}
+}
+
 func TestScrollToggle(t *testing.T) {
 	cfg := &config.SimulationConfig{}
 	m := newTUIModel(cfg, nil, unicodeSymbols)
@@ -802,3 +821,83 @@ func TestMapZoomPan(t *testing.T) {
 		t.Fatalf("expected pan right to increase min lon")
 	}
 }
+
+func TestMapShowsPointsOfInterest(t *testing.T) {
+	cfg := &config.SimulationConfig{PointsOfInterest: []config.PointOfInterest{
+		{ID: "s1", Type: "survivor", Lat: 0, Lon: 0},
+		{ID: "w1", Type: "wreckage", Lat: 0.1, Lon: 0.1},
+	}}
+	m := newTUIModel(cfg, nil, unicodeSymbols)
+	mi, _ := m.Update(tea.WindowSizeMsg{Width: 40, Height: 20})
+	m = mi.(tuiModel)
+	m.initMapViewport()
+	out := m.renderMap()
+	if !strings.Contains(out, colorMagenta+"S"+colorReset) || !strings.Contains(out, colorMagenta+"W"+colorReset) {
+		t.Fatalf("expected missing POI markers: %q", out)
+	}
+
+	row := poi.DetectionRow{DroneID: "d1", POIID: "s1", POIType: poi.Survivor, Status: poi.StatusFound, Timestamp: time.Unix(0, 0).UTC()}
+	mi, _ = m.Update(poiMsg{line: "poi-line", row: row})
+	m = mi.(tuiModel)
+	if !strings.Contains(m.renderMap(), colorGreen+"S"+colorReset) {
+		t.Fatalf("expected found survivor marker: %q", m.renderMap())
+	}
+	if m.detLogs[len(m.detLogs)-1] != "poi-line" {
+		t.Fatalf("expected POI detection in detection log, got %v", m.detLogs)
+	}
+
+	mi, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'m'}})
+	m = mi.(tuiModel)
+	mi, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'6'}})
+	m = mi.(tuiModel)
+	if strings.Contains(m.renderMap(), colorGreen+"S"+colorReset) {
+		t.Fatalf("POI layer not toggled off")
+	}
+}
+
+func TestMapShowsFixedAssets(t *testing.T) {
+	cfg := &config.SimulationConfig{FixedAssets: []config.FixedAsset{{ID: "station", Lat: 0, Lon: 0}}}
+	m := newTUIModel(cfg, nil, unicodeSymbols)
+	mi, _ := m.Update(tea.WindowSizeMsg{Width: 40, Height: 20})
+	m = mi.(tuiModel)
+	m.initMapViewport()
+	if !strings.Contains(m.renderMap(), colorGreen+"A"+colorReset) {
+		t.Fatalf("expected operational asset marker: %q", m.renderMap())
+	}
+
+	mi, _ = m.Update(assetMsg{row: asset.StateRow{AssetID: "station", Status: asset.StatusDamaged, HitPoints: 40}})
+	m = mi.(tuiModel)
+	if !strings.Contains(m.renderMap(), colorYellow+"A"+colorReset) {
+		t.Fatalf("expected damaged asset marker: %q", m.renderMap())
+	}
+
+	mi, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'m'}})
+	m = mi.(tuiModel)
+	mi, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'7'}})
+	m = mi.(tuiModel)
+	if strings.Contains(m.renderMap(), colorYellow+"A"+colorReset) {
+		t.Fatalf("asset layer not toggled off")
+	}
+}
+
+func TestMapShowsWeatherCells(t *testing.T) {
+	cfg := &config.SimulationConfig{Zones: []config.Region{{Name: "z", RadiusKM: 10}}}
+	m := newTUIModel(cfg, nil, unicodeSymbols)
+	mi, _ := m.Update(tea.WindowSizeMsg{Width: 60, Height: 30})
+	m = mi.(tuiModel)
+	mi, _ = m.Update(weatherMsg{cells: []MapWeatherCell{{ID: "storm", Type: weather.TypeStorm, RadiusM: 2000, Intensity: 1}}})
+	m = mi.(tuiModel)
+	m.initMapViewport()
+	storm := weatherSymbol(weather.TypeStorm)
+	if strings.Count(m.renderMap(), storm) < 2 {
+		t.Fatalf("expected storm outline on the map: %q", m.renderMap())
+	}
+
+	mi, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'m'}})
+	m = mi.(tuiModel)
+	mi, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'8'}})
+	m = mi.(tuiModel)
+	if n := strings.Count(m.renderMap(), storm); n != 1 {
+		t.Fatalf("weather layer not toggled off, %d storm symbols left", n)
+	}
+}
@@ -0,0 +1,85 @@
+package sim
+
+import (
+	"fmt"
+	log "log/slog"
+
+	"droneops-sim/internal/telemetry"
+	"droneops-sim/internal/weather"
+)
+
+const (
+	defaultWeatherRadiusM   = 5000.0 // cell radius when none is configured
+	defaultWeatherIntensity = 0.5    // cell intensity when none is configured
+)
+
+// AddWeatherCell places a weather cell. Missing IDs are generated, the type
+// defaults to storm and radius and intensity fall back to defaults.
+func (s *Simulator) AddWeatherCell(c weather.Cell) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.addWeatherCell(c)
+}
+
+func (s *Simulator) addWeatherCell(c weather.Cell) {
+	if c.ID == "" {
+		c.ID = fmt.Sprintf("weather-%d", len(s.weatherCells))
+	}
+	if c.Type == "" {
+		c.Type = weather.TypeStorm
+	}
+	if c.RadiusM <= 0 {
+		c.RadiusM = defaultWeatherRadiusM
+	}
+	if c.Intensity <= 0 {
+		c.Intensity = defaultWeatherIntensity
+	}
+	s.weatherCells = append(s.weatherCells, &c)
+}
+
+// stepWeather drifts every weather cell for one tick.
+func (s *Simulator) stepWeather() {
+	for _, c := range s.weatherCells {
+		c.Step(s.tickInterval)
+	}
+}
+
+// conditionsAt returns the sensor and communication degradation at pos: the
+// global weather impact and communication loss compounded with the weather
+// cells covering pos.
+func (s *Simulator) conditionsAt(pos telemetry.Position) weather.Conditions {
+	global := weather.Conditions{SensorImpact: s.weatherImpact, CommLoss: s.commLoss}
+	return weather.At(global, s.weatherCells, pos)
+}
+
+// mapWeather converts the weather cells for the map views.
+func (s *Simulator) mapWeather() []MapWeatherCell {
+	var cells []MapWeatherCell
+	for _, c := range s.weatherCells {
+		cells = append(cells, MapWeatherCell{
+			ID:              c.ID,
+			Type:            c.Type,
+			Lat:             c.Position.Lat,
+			Lon:             c.Position.Lon,
+			RadiusM:         c.RadiusM,
+			Intensity:       c.Intensity,
+			DriftSpeedMPS:   c.DriftSpeedMPS,
+			DriftHeadingDeg: c.DriftHeadingDeg,
+		})
+	}
+	return cells
+}
+
+// writeWeather sends the current weather cells to writers that draw them.
+func (s *Simulator) writeWeather() {
+	if len(s.weatherCells) == 0 {
+		return
+	}
+	ww, ok := s.writer.(WeatherWriter)
+	if !ok {
+		return
+	}
+	if err := ww.WriteWeather(s.mapWeather()); err != nil {
+		log.Error("weather write failed", "err", err)
+	}
+}
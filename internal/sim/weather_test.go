@@ -0,0 +1,68 @@
+package sim
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+	"time"
+
+	"droneops-sim/internal/config"
+	"droneops-sim/internal/geo"
+	"droneops-sim/internal/telemetry"
+	"droneops-sim/internal/weather"
+)
+
+func TestWeatherCellsDegradeLocally(t *testing.T) {
+	cfg := &config.SimulationConfig{
+		Zones:            []config.Region{{Name: "z", RadiusKM: 10}},
+		DetectionRadiusM: 1000,
+		WeatherCells: []config.WeatherCell{
+			{ID: "fog", Type: "fog", Lat: 0.05, RadiusKM: 2, Intensity: 1, DriftSpeedMPS: 100, DriftHeadingDeg: 180},
+		},
+	}
+	sim := NewSimulator("c", cfg, &MockWriter{}, nil, time.Second, rand.New(rand.NewSource(1)), nil)
+	clear := telemetry.Position{}
+	foggy := telemetry.Position{Lat: 0.05}
+	if c := sim.detectionConfidence(clear, 500); c != 50 {
+		t.Fatalf("expected clear-sky confidence 50, got %.2f", c)
+	}
+	if c := sim.detectionConfidence(foggy, 500); c != 0 {
+		t.Fatalf("expected fog at its center to blind sensors, got %.2f", c)
+	}
+	if l := sim.conditionsAt(foggy).CommLoss; math.Abs(l-0.1) > 1e-9 {
+		t.Fatalf("expected light comm loss in fog, got %.2f", l)
+	}
+
+	for i := 0; i < 10; i++ {
+		sim.stepWeather()
+	}
+	cells := sim.MapSnapshot().Weather
+	if len(cells) != 1 || cells[0].Type != weather.TypeFog || math.Abs(geo.Distance(cells[0].Lat, cells[0].Lon, 0.05, 0)-1000) > 1e-6 || cells[0].Lat >= 0.05 {
+		t.Fatalf("expected fog drifted 1 km south on the map, got %+v", cells)
+	}
+}
+
+func TestSendCommandUsesLocalCommLoss(t *testing.T) {
+	cfg := &config.SimulationConfig{
+		Zones:        []config.Region{{Name: "z", RadiusKM: 10}},
+		WeatherCells: []config.WeatherCell{{Lat: 0.05, RadiusKM: 0.5, Intensity: 1}},
+	}
+	sim := NewSimulator("c", cfg, &MockWriter{}, nil, time.Second, rand.New(rand.NewSource(1)), nil)
+	if sim.weatherCells[0].ID != "weather-0" || sim.weatherCells[0].Type != weather.TypeStorm {
+		t.Fatalf("expected defaults for an unnamed cell, got %+v", sim.weatherCells[0])
+	}
+	inside := &telemetry.Drone{Position: telemetry.Position{Lat: 0.05}}
+	outside := &telemetry.Drone{}
+	lost := 0
+	for i := 0; i < 200; i++ {
+		if !sim.sendCommand(outside) {
+			t.Fatalf("expected commands outside the storm to arrive")
+		}
+		if !sim.sendCommand(inside) {
+			lost++
+		}
+	}
+	if lost < 80 || lost > 160 {
+		t.Fatalf("expected about 60%% of commands into the storm lost, got %d of 200", lost)
+	}
+}
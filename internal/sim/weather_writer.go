@@ -0,0 +1,7 @@
+package sim
+
+// WeatherWriter receives the current weather cells every tick, for outputs
+// that draw them such as the TUI map.
+type WeatherWriter interface {
+	WriteWeather([]MapWeatherCell) error
+}
@@ -0,0 +1,46 @@
+package sim
+
+import (
+	"math"
+
+	"droneops-sim/internal/telemetry"
+)
+
+// updateWind draws the gust of this tick and sets the local wind of every
+// drone. The prevailing wind is returned for the simulation state.
+func (s *Simulator) updateWind(drones []*telemetry.Drone) telemetry.Wind {
+	s.windGust = 0
+	if s.cfg.Wind.GustMPS > 0 {
+		s.windGust = s.rand.Float64() * s.cfg.Wind.GustMPS
+	}
+	for _, d := range drones {
+		d.Wind = s.windAt(d.Position)
+	}
+	return s.wind(s.cfg.Wind.DirectionDeg, s.cfg.Wind.SpeedMPS)
+}
+
+// windAt returns the wind at pos. The first regional entry whose zone
+// contains pos replaces the prevailing direction and speed.
+func (s *Simulator) windAt(pos telemetry.Position) telemetry.Wind {
+	for _, r := range s.cfg.Wind.Regions {
+		for _, z := range s.cfg.Zones {
+			if z.Name == r.Region && z.Telemetry().Contains(pos.Lat, pos.Lon) {
+				return s.wind(r.DirectionDeg, r.SpeedMPS)
+			}
+		}
+	}
+	return s.wind(s.cfg.Wind.DirectionDeg, s.cfg.Wind.SpeedMPS)
+}
+
+// wind veers dir for the elapsed time and adds the gust of the current tick
+// to speed.
+func (s *Simulator) wind(dir, speed float64) telemetry.Wind {
+	if w := s.cfg.Wind; w.VeerDeg > 0 && w.PeriodS > 0 {
+		dir += w.VeerDeg * math.Sin(2*math.Pi*s.elapsed()/w.PeriodS)
+	}
+	speed += s.windGust
+	if speed <= 0 {
+		return telemetry.Wind{}
+	}
+	return telemetry.Wind{DirectionDeg: math.Mod(dir+360, 360), SpeedMPS: speed}
+}
@@ -0,0 +1,44 @@
+package sim
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"testing"
+	"time"
+
+	"droneops-sim/internal/config"
+	"droneops-sim/internal/telemetry"
+)
+
+func TestWindVariesByRegionAndTime(t *testing.T) {
+	now := time.Unix(0, 0).UTC()
+	cfg := &config.SimulationConfig{
+		Zones: []config.Region{{Name: "south", RadiusKM: 1}, {Name: "north", CenterLat: 1, RadiusKM: 1}},
+		Fleets: []config.Fleet{
+			{Name: "s", Model: "small-fpv", Count: 1, HomeRegion: "south"},
+			{Name: "n", Model: "small-fpv", Count: 1, HomeRegion: "north"},
+		},
+		Wind: config.Wind{
+			DirectionDeg: 270, SpeedMPS: 5, VeerDeg: 30, PeriodS: 40,
+			Regions: []config.RegionalWind{{Region: "north", DirectionDeg: 0, SpeedMPS: 12}},
+		},
+	}
+	w := &mockAllWriter{}
+	sim := NewSimulator("c", cfg, w, nil, time.Second, rand.New(rand.NewSource(1)), func() time.Time { return now })
+	sim.enemyEng.Enemies = nil
+
+	sim.tick(context.Background())
+	if st := w.states[len(w.states)-1]; st.WindDirectionDeg != 270 || st.WindSpeedMPS != 5 {
+		t.Fatalf("expected prevailing wind in state row, got %+v", st)
+	}
+	if s, n := sim.fleets[0].Drones[0].Wind, sim.fleets[1].Drones[0].Wind; s.SpeedMPS != 5 || n.SpeedMPS != 12 || n.DirectionDeg != 0 {
+		t.Fatalf("expected regional wind override, got south %+v north %+v", s, n)
+	}
+
+	// a quarter period later the wind has veered by the full amount
+	now = now.Add(10 * time.Second)
+	if got := sim.windAt(telemetry.Position{}); math.Abs(got.DirectionDeg-300) > 1e-9 {
+		t.Fatalf("expected wind veered to 300, got %+v", got)
+	}
+}
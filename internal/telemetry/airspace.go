@@ -0,0 +1,116 @@
+package telemetry
+
+import (
+	"math"
+	"time"
+
+	"droneops-sim/internal/geo"
+)
+
+// Geofence breach events.
+const (
+	GeofenceEntry = "entry"
+	GeofenceExit  = "exit"
+)
+
+// avoidStepDeg and avoidMaxDeg bound the turns tried to route a drone around
+// restricted airspace, alternating left and right of its course.
+const (
+	avoidStepDeg = 15.0
+	avoidMaxDeg  = 90.0
+)
+
+// Airspace is a restricted area drones must not enter, between FloorM and
+// CeilingM meters above the ground. A zero floor reaches the ground and a
+// zero ceiling is unlimited.
+type Airspace struct {
+	ID       string           // Identifier of the restricted area
+	Shape    geo.MultiPolygon // Horizontal boundary
+	FloorM   float64          // Lowest restricted height
+	CeilingM float64          // Highest restricted height, unlimited when zero
+	Terrain  Terrain          // Ground under the area, sea level when nil
+}
+
+// GeofenceBreachRow represents a drone entering or leaving restricted
+// airspace. Entries carry the penetration depth at the entry, exits the
+// deepest penetration of the breach.
+type GeofenceBreachRow struct {
+	ClusterID    string    `json:"cluster_id"`    // TAG
+	DroneID      string    `json:"drone_id"`      // TAG
+	ZoneID       string    `json:"zone_id"`       // TAG
+	Event        string    `json:"event"`         // FIELD entry or exit
+	PenetrationM float64   `json:"penetration_m"` // FIELD distance inside the nearest boundary
+	Lat          float64   `json:"lat"`           // FIELD
+	Lon          float64   `json:"lon"`           // FIELD
+	Alt          float64   `json:"alt"`           // FIELD
+	Timestamp    time.Time `json:"ts"`            // TIME INDEX
+}
+
+// height returns the height of pos above the ground under it.
+func (a Airspace) height(pos Position) float64 {
+	if a.Terrain == nil {
+		return pos.Alt
+	}
+	return pos.Alt - a.Terrain.Elevation(pos.Lat, pos.Lon)
+}
+
+// Contains reports whether pos lies inside the airspace.
+func (a Airspace) Contains(pos Position) bool {
+	h := a.height(pos)
+	if h < a.FloorM || (a.CeilingM > 0 && h > a.CeilingM) {
+		return false
+	}
+	return a.Shape.Contains(pos.Lat, pos.Lon)
+}
+
+// Depth returns how far pos lies inside the airspace: the distance to the
+// nearest side, floor or ceiling, zero outside.
+func (a Airspace) Depth(pos Position) float64 {
+	if !a.Contains(pos) {
+		return 0
+	}
+	d, h := a.Shape.DistanceToBoundary(pos.Lat, pos.Lon), a.height(pos)
+	if a.FloorM > 0 {
+		d = math.Min(d, h-a.FloorM)
+	}
+	if a.CeilingM > 0 {
+		d = math.Min(d, a.CeilingM-h)
+	}
+	return d
+}
+
+// restricted reports whether pos lies inside any of the airspaces.
+func restricted(airspaces []Airspace, pos Position) bool {
+	for _, a := range airspaces {
+		if a.Contains(pos) {
+			return true
+		}
+	}
+	return false
+}
+
+// avoid routes a drone that moved from from to to around the restricted
+// airspace it would enter. The move is turned in growing steps to either
+// side until it stays clear, and the drone holds its position when no turn
+// does. Drones already inside fly on, so they can leave.
+func avoid(drone *Drone, from, to Position) Position {
+	if len(drone.NoFly) == 0 || !restricted(drone.NoFly, to) || restricted(drone.NoFly, from) {
+		return to
+	}
+	dist := geo.Distance(from.Lat, from.Lon, to.Lat, to.Lon)
+	course := geo.Bearing(from.Lat, from.Lon, to.Lat, to.Lon)
+	for turn := avoidStepDeg; turn <= avoidMaxDeg && dist > 0; turn += avoidStepDeg {
+		for _, side := range []float64{1, -1} {
+			heading := math.Mod(course+side*turn+360, 360)
+			next := ahead(Position{Lat: from.Lat, Lon: from.Lon, Alt: to.Alt}, heading, dist)
+			if !restricted(drone.NoFly, next) {
+				drone.HeadingDeg = heading
+				return next
+			}
+		}
+	}
+	if hold := (Position{Lat: from.Lat, Lon: from.Lon, Alt: to.Alt}); !restricted(drone.NoFly, hold) {
+		return hold
+	}
+	return from
+}
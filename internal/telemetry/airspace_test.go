@@ -0,0 +1,121 @@
+package telemetry
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"droneops-sim/internal/geo"
+)
+
+// block is a restricted square between 16.41 and 16.43 east, up to 500 m.
+var block = Airspace{
+	ID:       "block",
+	Shape:    geo.MultiPolygon{{geo.Ring{{Lat: 48.19, Lon: 16.41}, {Lat: 48.19, Lon: 16.43}, {Lat: 48.21, Lon: 16.43}, {Lat: 48.21, Lon: 16.41}}}},
+	CeilingM: 500,
+}
+
+func TestAirspaceContainsAndDepth(t *testing.T) {
+	center := Position{Lat: 48.2, Lon: 16.42, Alt: 100}
+	if !block.Contains(center) || block.Contains(Position{Lat: 48.2, Lon: 16.42, Alt: 600}) || block.Contains(Position{Lat: 48.2, Lon: 16.44, Alt: 100}) {
+		t.Fatalf("expected membership inside the square below the ceiling")
+	}
+	if d := block.Depth(center); d != 400 {
+		t.Fatalf("expected the ceiling to bound the depth, got %.1f m", d)
+	}
+	low := Airspace{Shape: block.Shape, FloorM: 50}
+	edge := geo.Distance(48.2, 16.42, 48.2, 16.43)
+	if d := low.Depth(Position{Lat: 48.2, Lon: 16.42, Alt: 1000}); d < edge*0.99 || d > edge*1.01 {
+		t.Fatalf("expected the distance to the nearest side, %.1f m, got %.1f m", edge, d)
+	}
+	if d := block.Depth(Position{Lat: 48.2, Lon: 16.44, Alt: 100}); d != 0 {
+		t.Fatalf("expected no depth outside, got %.1f m", d)
+	}
+}
+
+func TestAirspaceHeightsAboveTerrain(t *testing.T) {
+	high := block
+	high.Terrain = cliff{} // 150 m of ground under the square
+	pos := Position{Lat: 48.2, Lon: 16.42, Alt: 600}
+	if block.Contains(pos) || !high.Contains(pos) {
+		t.Fatalf("expected the ceiling 500 m above the ground, not sea level")
+	}
+	if d := high.Depth(pos); d != 50 {
+		t.Fatalf("expected 50 m below the ceiling, got %.1f m", d)
+	}
+}
+
+func TestDronesRouteAroundRestrictedAirspace(t *testing.T) {
+	gen := NewGenerator("c", rand.New(rand.NewSource(1)), nil)
+	start := Position{Lat: 48.2, Lon: 16.4, Alt: 100}
+	drone := &Drone{
+		Model:           "medium-uav",
+		MovementPattern: "point-to-point",
+		Position:        start,
+		Waypoints:       []Position{{Lat: 48.2, Lon: 16.44, Alt: 100}},
+		RouteMode:       "one-way",
+		HeadingDeg:      90,
+		SpeedMPS:        20,
+		Battery:         100,
+		NoFly:           []Airspace{block},
+	}
+	prev := start
+	for i := 0; i < 600; i++ {
+		gen.GenerateTelemetry(drone, prev, time.Second)
+		if block.Contains(drone.Position) {
+			t.Fatalf("tick %d: drone entered the restricted area at %+v", i, drone.Position)
+		}
+		prev = drone.Position
+	}
+	if drone.Position.Lon <= 16.43 {
+		t.Fatalf("expected the drone past the area, got %+v", drone.Position)
+	}
+
+	pursuer := &Drone{Model: "medium-uav", Position: start, HeadingDeg: 90, SpeedMPS: 20, Battery: 100, NoFly: []Airspace{block}}
+	pursuer.FollowTarget = &Position{Lat: 48.2, Lon: 16.42, Alt: 100}
+	prev = start
+	for i := 0; i < 300 && !block.Contains(pursuer.Position); i++ {
+		gen.GenerateTelemetry(pursuer, prev, time.Second)
+		prev = pursuer.Position
+	}
+	if !block.Contains(pursuer.Position) {
+		t.Fatalf("expected a pursuing drone to follow its target into the area")
+	}
+}
+
+func TestDronesSkipWaypointsInsideRestrictedAirspace(t *testing.T) {
+	gen := NewGenerator("c", rand.New(rand.NewSource(1)), nil)
+	start := Position{Lat: 48.2, Lon: 16.4, Alt: 100}
+	drone := &Drone{
+		Model:           "medium-uav",
+		MovementPattern: "point-to-point",
+		Position:        start,
+		Waypoints:       []Position{start, {Lat: 48.2, Lon: 16.42, Alt: 100}, {Lat: 48.2, Lon: 16.44, Alt: 100}},
+		RouteMode:       RouteLoop,
+		WaypointIndex:   1,
+		HeadingDeg:      90,
+		SpeedMPS:        20,
+		Battery:         100,
+		NoFly:           []Airspace{block},
+	}
+	prev, east := start, false
+	for i := 0; i < 600; i++ {
+		gen.GenerateTelemetry(drone, prev, time.Second)
+		if block.Contains(drone.Position) {
+			t.Fatalf("tick %d: drone entered the restricted area at %+v", i, drone.Position)
+		}
+		east = east || drone.Position.Lon > 16.435
+		prev = drone.Position
+	}
+	if !east || drone.WaypointIndex == 1 {
+		t.Fatalf("expected the drone to skip the waypoint inside the area and fly on, got %+v", drone)
+	}
+
+	drone.Position, drone.SpeedMPS, drone.Waypoints, drone.RouteMode = start, 0, drone.Waypoints[1:2], RouteOneWay
+	for i := 0; i < 60; i++ {
+		gen.GenerateTelemetry(drone, drone.Position, time.Second)
+	}
+	if d := geo.Distance(start.Lat, start.Lon, drone.Position.Lat, drone.Position.Lon); d > 10 {
+		t.Fatalf("expected the drone to hold without a waypoint outside the area, moved %.1f m", d)
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"math"
 	"math/rand"
 	"time"
+
+	"droneops-sim/internal/geo"
 )
 
 // Generator simulates telemetry for a fleet of drones.
@@ -27,42 +29,71 @@ func NewGenerator(clusterID string, r *rand.Rand, now func() time.Time) *Generat
 // GenerateTelemetry updates a drone's state and returns a TelemetryRow ready for DB write.
 // prev is the drone's previous position and dt is the elapsed time since the last tick.
 func (g *Generator) GenerateTelemetry(drone *Drone, prev Position, dt time.Duration) TelemetryRow {
+	// Launch, return home or land before moving
+	advancePhase(drone)
+
 	var strategy MovementStrategy
+	avoids := true // Routed around restricted airspace
 
-	// If a follow target is set, override movement pattern
-	if drone.FollowTarget != nil {
+	switch {
+	case drone.Crashed:
+		// Wreckage stays where the drone came down
+		strategy = WreckMovement{}
+		avoids = false
+	case drone.pursuing():
+		// If a follow target is set, override movement pattern; pursuit
+		// ignores restricted airspace
 		strategy = FollowMovement{Target: *drone.FollowTarget}
-	} else {
-		// Select movement strategy based on drone's movement pattern
-		switch drone.MovementPattern {
-		case "patrol":
-			strategy = PatrolMovement{}
-		case "point-to-point":
-			strategy = PointToPointMovement{}
-		case "loiter":
-			strategy = LoiterMovement{}
-		default:
-			strategy = RandomWalkMovement{} // Implement RandomWalkMovement similarly
-		}
+		avoids = false
+	case drone.Phase != "" && drone.Phase != PhaseOnStation:
+		// Outside of the mission area the lifecycle phase decides
+		strategy = LifecycleMovement{}
+	case drone.FormationSlot != nil:
+		// Escorts and formation followers hold their slot
+		strategy = FollowMovement{Target: *drone.FormationSlot}
+	default:
+		// The registered movement pattern, random walk if unknown
+		strategy = NewStrategy(drone.MovementPattern, drone.PatternParams)
 	}
 
-	// Update drone's position using the selected strategy
-	drone.Position = strategy.Move(drone, drone.HomeRegion, drone.Waypoints, g.rand)
+	// Update drone's position using the selected strategy, then let the
+	// wind push it off its track
+	from := drone.Position
+	drone.Position = strategy.Move(drone, drone.HomeRegion, drone.Waypoints, dt, g.rand)
+	drone.Position = drift(drone, drone.Position, dt)
+	if avoids {
+		drone.Position = avoid(drone, from, drone.Position)
+	}
+	drone.Position = followTerrain(drone, from, drone.Position, dt)
 
-	// Battery drain
-	drone.Battery -= batteryDrain(drone.Model)
+	// Battery drain, higher into a headwind; drones on the ground and
+	// wreckage are powered down
+	if drone.Phase != PhaseIdle && !drone.Crashed {
+		drone.Battery -= drainRate(drone) * windDrain(drone, drone.HeadingDeg) * dt.Seconds()
+	}
 	if drone.Battery < 0 {
 		drone.Battery = 0
 	}
 
-	// Status
+	// Status, with random failures injected at the configured rate; a
+	// failed drone, like one whose battery ran flat in the air, comes down
+	// and stays down
 	drone.Status = batteryStatus(drone.Battery)
+	if g.rand.Float64() < failureChance(drone, dt) {
+		drone.Crashed = true
+	}
+	if drone.Battery <= 0 && drone.Phase != PhaseIdle {
+		drone.Crashed = true
+	}
+	if drone.Crashed {
+		drone.Status = StatusFailure
+	}
 
 	var speed float64
 	var heading float64
 	if dt > 0 {
-		speed = distanceMeters(prev.Lat, prev.Lon, drone.Position.Lat, drone.Position.Lon) / dt.Seconds()
-		heading = bearingDegrees(prev.Lat, prev.Lon, drone.Position.Lat, drone.Position.Lon)
+		speed = geo.Distance(prev.Lat, prev.Lon, drone.Position.Lat, drone.Position.Lon) / dt.Seconds()
+		heading = geo.Bearing(prev.Lat, prev.Lon, drone.Position.Lat, drone.Position.Lon)
 	}
 
 	return TelemetryRow{
@@ -72,12 +103,16 @@ func (g *Generator) GenerateTelemetry(drone *Drone, prev Position, dt time.Durat
 		Lat:              drone.Position.Lat,
 		Lon:              drone.Position.Lon,
 		Alt:              drone.Position.Alt,
+		AltAGL:           drone.AGL(),
 		Battery:          drone.Battery,
 		Status:           drone.Status,
 		Follow:           drone.FollowTarget != nil,
 		MovementPattern:  drone.MovementPattern,
 		SpeedMPS:         speed,
+		AirspeedMPS:      drone.SpeedMPS,
 		HeadingDeg:       heading,
+		WaypointIndex:    drone.WaypointIndex,
+		Phase:            drone.Phase,
 		PreviousPosition: prev,
 		SyncedFrom:       "",
 		SyncedID:         "",
@@ -86,115 +121,230 @@ func (g *Generator) GenerateTelemetry(drone *Drone, prev Position, dt time.Durat
 	}
 }
 
-// MovementStrategy defines the interface for drone movement.
+// failureChance returns the probability that a powered drone fails within
+// dt at its per-second failure rate.
+func failureChance(drone *Drone, dt time.Duration) float64 {
+	rate := drone.Behavior.FailureRate
+	if drone.Crashed || drone.Phase == PhaseIdle || rate <= 0 {
+		return 0
+	}
+	if rate >= 1 {
+		return 1
+	}
+	return 1 - math.Pow(1-rate, dt.Seconds())
+}
+
+// MovementStrategy defines the interface for drone movement. Strategies pick
+// a goal and steer toward it within the drone's speed band and kinematic
+// limits over dt.
 type MovementStrategy interface {
-	Move(drone *Drone, region Region, waypoints []Position, r *rand.Rand) Position
+	Move(drone *Drone, region Region, waypoints []Position, dt time.Duration, r *rand.Rand) Position
 }
 
-// PatrolMovement implements circular movement around the home region's center.
-type PatrolMovement struct{}
+// PatrolMovement implements circular movement around the home region's center
+// on a ring of RadiusScale times the region's radius, 0.99 if unset. Polygon
+// regions are patrolled around their anchor, the ring pulled inside the
+// shape where it crosses the boundary.
+type PatrolMovement struct{ RadiusScale float64 }
 
-func (p PatrolMovement) Move(drone *Drone, region Region, waypoints []Position, r *rand.Rand) Position {
-	radius := region.RadiusKM * 1000 * 0.99 // Scale radius slightly to ensure position stays within bounds
-	angle := r.Float64() * 2 * math.Pi
-	deltaLat := (radius * math.Cos(angle)) / 111000
-	deltaLon := (radius * math.Sin(angle)) / (111000 * math.Cos(region.CenterLat*math.Pi/180))
-	return Position{
-		Lat: region.CenterLat + deltaLat,
-		Lon: region.CenterLon + deltaLon,
-		Alt: drone.Position.Alt,
+func (p PatrolMovement) Move(drone *Drone, region Region, waypoints []Position, dt time.Duration, r *rand.Rand) Position {
+	scale := p.RadiusScale
+	if scale <= 0 {
+		scale = 0.99 // Scale radius slightly to ensure position stays within bounds
+	}
+	radius := region.RadiusKM * 1000 * scale
+	speed := cruiseSpeed(drone, r)
+	lat, lon := region.Anchor()
+	angle := geo.Bearing(lat, lon, drone.Position.Lat, drone.Position.Lon)
+	if geo.Distance(lat, lon, drone.Position.Lat, drone.Position.Lon) < 1 {
+		angle = r.Float64() * 360 // Drones at the center head for a random point of the ring
+	}
+	if radius > 0 {
+		angle += 3 * speed * dt.Seconds() / radius * 180 / math.Pi // Aim a few ticks ahead clockwise along the ring
 	}
+	center := Position{Lat: lat, Lon: lon, Alt: drone.Position.Alt}
+	goal := ahead(center, angle, radius)
+	for i := 0; i < 10 && region.Shape != nil && !region.Contains(goal.Lat, goal.Lon); i++ {
+		radius *= 0.8 // Pull the ring inside the polygon where it crosses the boundary
+		goal = ahead(center, angle, radius)
+	}
+	if region.Shape != nil && !region.Contains(goal.Lat, goal.Lon) {
+		goal = center // Head back inside across a notch of the shape
+	}
+	return steer(drone, goal, speed, dt)
 }
 
-// PointToPointMovement implements movement between predefined waypoints.
+// PointToPointMovement flies the waypoints in order. Reaching a waypoint
+// advances the drone's WaypointIndex according to its route mode, and so do
+// waypoints inside active restricted airspace, which are skipped. Drones
+// whose route has no waypoint left outside it hold their position.
 type PointToPointMovement struct{}
 
-func (p PointToPointMovement) Move(drone *Drone, region Region, waypoints []Position, r *rand.Rand) Position {
+func (p PointToPointMovement) Move(drone *Drone, region Region, waypoints []Position, dt time.Duration, r *rand.Rand) Position {
 	if len(waypoints) == 0 {
-		return drone.Position
+		return steer(drone, drone.Position, 0, dt)
+	}
+	if drone.WaypointIndex < 0 || drone.WaypointIndex >= len(waypoints) {
+		drone.WaypointIndex = 0
+	}
+	arrival := drone.ArrivalRadiusM
+	if arrival <= 0 {
+		arrival = DefaultArrivalRadiusM
 	}
-	target := waypoints[r.Intn(len(waypoints))]
-	deltaLat := (target.Lat - drone.Position.Lat) / 10 // Gradual movement
-	deltaLon := (target.Lon - drone.Position.Lon) / 10
-	return Position{
-		Lat: drone.Position.Lat + deltaLat,
-		Lon: drone.Position.Lon + deltaLon,
-		Alt: drone.Position.Alt,
+	for i := 0; i < 2*len(waypoints) && restricted(drone.NoFly, waypoints[drone.WaypointIndex]); i++ {
+		advanceWaypoint(drone, len(waypoints)) // Ping-pong routes turn around within two passes
 	}
+	target := waypoints[drone.WaypointIndex]
+	if restricted(drone.NoFly, target) {
+		return steer(drone, drone.Position, 0, dt)
+	}
+	pos := steer(drone, target, cruiseSpeed(drone, r), dt)
+	if geo.Distance(pos.Lat, pos.Lon, target.Lat, target.Lon) <= arrival {
+		advanceWaypoint(drone, len(waypoints))
+	}
+	return pos
 }
 
-// LoiterMovement implements hovering near the home region's center.
+// advanceWaypoint moves the drone's WaypointIndex past the reached waypoint.
+func advanceWaypoint(drone *Drone, n int) {
+	switch drone.RouteMode {
+	case RouteOneWay:
+		if drone.WaypointIndex < n-1 {
+			drone.WaypointIndex++
+		}
+	case RoutePingPong:
+		if n < 2 {
+			return
+		}
+		if drone.WaypointIndex == n-1 {
+			drone.RouteReversed = true
+		} else if drone.WaypointIndex == 0 {
+			drone.RouteReversed = false
+		}
+		if drone.RouteReversed {
+			drone.WaypointIndex--
+		} else {
+			drone.WaypointIndex++
+		}
+	default:
+		drone.WaypointIndex = (drone.WaypointIndex + 1) % n
+	}
+}
+
+// loiterJitterM is how far north and south, east and west of the home
+// region's anchor loitering drones wander.
+const loiterJitterM = 5.0
+
+// LoiterMovement implements hovering near the home region's center, or its
+// anchor inside polygon regions.
 type LoiterMovement struct{}
 
-func (l LoiterMovement) Move(drone *Drone, region Region, waypoints []Position, r *rand.Rand) Position {
-	deltaLat := r.Float64()*0.0001 - 0.00005 // Small random movement
-	deltaLon := r.Float64()*0.0001 - 0.00005
-	return Position{
-		Lat: region.CenterLat + deltaLat,
-		Lon: region.CenterLon + deltaLon,
-		Alt: drone.Position.Alt,
-	}
+func (l LoiterMovement) Move(drone *Drone, region Region, waypoints []Position, dt time.Duration, r *rand.Rand) Position {
+	goal := loiterGoal(region, r)
+	goal.Alt = drone.Position.Alt
+	return steer(drone, goal, cruiseSpeed(drone, r), dt)
 }
 
-// RandomWalkMovement implements random movement within the region.
-type RandomWalkMovement struct{}
+// loiterGoal returns a random point within loiterJitterM meters north and
+// east of the region's anchor.
+func loiterGoal(region Region, r *rand.Rand) Position {
+	north := (r.Float64()*2 - 1) * loiterJitterM
+	east := (r.Float64()*2 - 1) * loiterJitterM
+	lat, lon := region.Anchor()
+	lat, lon = geo.Offset(lat, lon, north, east)
+	return Position{Lat: lat, Lon: lon}
+}
 
-func (r RandomWalkMovement) Move(drone *Drone, region Region, waypoints []Position, rnd *rand.Rand) Position {
-	var speedMin, speedMax float64 // speed range, in meters
-	switch drone.Model {
-	case "small-fpv":
-		speedMin, speedMax = 15, 30
-	case "medium-uav":
-		speedMin, speedMax = 25, 50
-	case "large-uav":
-		speedMin, speedMax = 20, 40
-	default:
-		speedMin, speedMax = 15, 25
+// RandomWalkMovement implements random movement within the region, wandering
+// up to MaxTurnDeg off the current heading, 30 if unset.
+type RandomWalkMovement struct{ MaxTurnDeg float64 }
+
+func (r RandomWalkMovement) Move(drone *Drone, region Region, waypoints []Position, dt time.Duration, rnd *rand.Rand) Position {
+	turn := r.MaxTurnDeg
+	if turn <= 0 {
+		turn = 30
+	}
+	// Wander off the current heading, turning back toward the region's
+	// anchor once the drone leaves its region
+	heading := drone.HeadingDeg + rnd.Float64()*2*turn - turn
+	if region.RadiusKM > 0 && !region.Contains(drone.Position.Lat, drone.Position.Lon) {
+		lat, lon := region.Anchor()
+		heading = geo.Bearing(drone.Position.Lat, drone.Position.Lon, lat, lon)
 	}
+	speed := cruiseSpeed(drone, rnd)
+	goal := ahead(drone.Position, heading, 3*speed*dt.Seconds())
 
-	// Random heading (direction) in radians (0 to 2π)
-	heading := rnd.Float64() * 2 * math.Pi
+	// Altitude goal: random change between -1m and +1m
+	goal.Alt = math.Max(0, drone.Position.Alt+rnd.Float64()*2-1)
+	return steer(drone, goal, speed, dt)
+}
 
-	// Random speed within the range for the drone model
-	speed := rnd.Float64()*(speedMax-speedMin) + speedMin // m/s
+// FollowMovement moves the drone toward a target position at the top of its speed band.
+type FollowMovement struct{ Target Position }
 
-	// Convert speed and heading into latitude and longitude deltas
-	deltaLat := (speed * math.Cos(heading)) / 111000
-	deltaLon := (speed * math.Sin(heading)) / (111000 * math.Cos(drone.Position.Lat*math.Pi/180))
+func (f FollowMovement) Move(drone *Drone, region Region, waypoints []Position, dt time.Duration, r *rand.Rand) Position {
+	_, speedMax := speedBand(drone)
+	goal := Position{Lat: f.Target.Lat, Lon: f.Target.Lon, Alt: drone.Position.Alt}
+	return steer(drone, goal, speedMax, dt)
+}
 
-	// Altitude delta: random change between -1m and +1m
-	altDelta := rnd.Float64()*2 - 1 // ±1m
+// WreckMovement drops a crashed drone to the ground where it came down.
+type WreckMovement struct{}
 
-	// Return the new position, ensuring altitude is non-negative
-	return Position{
-		Lat: drone.Position.Lat + deltaLat,
-		Lon: drone.Position.Lon + deltaLon,
-		Alt: math.Max(0, drone.Position.Alt+altDelta),
-	}
+func (w WreckMovement) Move(drone *Drone, region Region, waypoints []Position, dt time.Duration, r *rand.Rand) Position {
+	drone.SpeedMPS, drone.ClimbRateMPS = 0, 0
+	return Position{Lat: drone.Position.Lat, Lon: drone.Position.Lon, Alt: drone.Ground(drone.Position)}
 }
 
-// FollowMovement moves the drone toward a target position.
-type FollowMovement struct{ Target Position }
+// cruiseSpeed returns the speed in m/s a drone aims for: its current speed
+// nudged by up to a tenth of its speed band, or a random speed within the
+// band while it flies outside of it.
+func cruiseSpeed(drone *Drone, r *rand.Rand) float64 {
+	speedMin, speedMax := speedBand(drone)
+	if drone.SpeedMPS < speedMin || drone.SpeedMPS > speedMax {
+		return r.Float64()*(speedMax-speedMin) + speedMin
+	}
+	v := drone.SpeedMPS + (r.Float64()*2-1)*0.1*(speedMax-speedMin)
+	return math.Max(speedMin, math.Min(speedMax, v))
+}
 
-func (f FollowMovement) Move(drone *Drone, region Region, waypoints []Position, r *rand.Rand) Position {
-	const step = 50.0 // meters per tick
-	dLat := (f.Target.Lat - drone.Position.Lat) * 111000
-	dLon := (f.Target.Lon - drone.Position.Lon) * 111000 * math.Cos(drone.Position.Lat*math.Pi/180)
-	dist := math.Hypot(dLat, dLon)
-	if dist == 0 {
-		return drone.Position
+// speedBand returns the drone's speed range in meters per second. Unset
+// bounds fall back to the model defaults.
+func speedBand(drone *Drone) (float64, float64) {
+	speedMin, speedMax := modelSpeed(drone.Model)
+	if drone.Behavior.SpeedMinKmh > 0 {
+		speedMin = drone.Behavior.SpeedMinKmh
 	}
-	factor := step / dist
-	if factor > 1 {
-		factor = 1
+	if drone.Behavior.SpeedMaxKmh > 0 {
+		speedMax = drone.Behavior.SpeedMaxKmh
 	}
-	deltaLat := (dLat * factor) / 111000
-	deltaLon := (dLon * factor) / (111000 * math.Cos(drone.Position.Lat*math.Pi/180))
-	return Position{
-		Lat: drone.Position.Lat + deltaLat,
-		Lon: drone.Position.Lon + deltaLon,
-		Alt: drone.Position.Alt,
+	if speedMax < speedMin {
+		speedMax = speedMin
 	}
+	return speedMin / 3.6, speedMax / 3.6
+}
+
+// modelSpeed returns the default speed range of a model in km/h.
+func modelSpeed(model string) (float64, float64) {
+	switch model {
+	case "small-fpv":
+		return 54, 108
+	case "medium-uav":
+		return 90, 180
+	case "large-uav":
+		return 72, 144
+	default:
+		return 54, 90
+	}
+}
+
+// drainRate returns the drone's battery consumption per second, falling back
+// to the model default.
+func drainRate(drone *Drone) float64 {
+	if drone.Behavior.BatteryDrainRate > 0 {
+		return drone.Behavior.BatteryDrainRate
+	}
+	return batteryDrain(drone.Model)
 }
 
 // batteryStatus determines the drone status based on remaining battery level.
@@ -209,7 +359,7 @@ func batteryStatus(level float64) string {
 	}
 }
 
-// batteryDrain returns battery consumption per tick based on model.
+// batteryDrain returns the default battery consumption per second of a model.
 func batteryDrain(model string) float64 {
 	switch model {
 	case "small-fpv":
@@ -222,27 +372,3 @@ func batteryDrain(model string) float64 {
 		return 0.4
 	}
 }
-
-// distanceMeters calculates the haversine distance between two lat/lon points.
-func distanceMeters(lat1, lon1, lat2, lon2 float64) float64 {
-	const earthRadius = 6371000.0
-	dLat := (lat2 - lat1) * math.Pi / 180
-	dLon := (lon2 - lon1) * math.Pi / 180
-	a := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1*math.Pi/180)*math.Cos(lat2*math.Pi/180)*math.Sin(dLon/2)*math.Sin(dLon/2)
-	c := 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
-	return earthRadius * c
-}
-
-// bearingDegrees calculates the initial bearing from point A to B.
-func bearingDegrees(lat1, lon1, lat2, lon2 float64) float64 {
-	lat1Rad := lat1 * math.Pi / 180
-	lat2Rad := lat2 * math.Pi / 180
-	dLon := (lon2 - lon1) * math.Pi / 180
-	y := math.Sin(dLon) * math.Cos(lat2Rad)
-	x := math.Cos(lat1Rad)*math.Sin(lat2Rad) - math.Sin(lat1Rad)*math.Cos(lat2Rad)*math.Cos(dLon)
-	brng := math.Atan2(y, x) * 180 / math.Pi
-	if brng < 0 {
-		brng += 360
-	}
-	return brng
-}
@@ -5,6 +5,8 @@ import (
 	"math/rand"
 	"testing"
 	"time"
+
+	"droneops-sim/internal/geo"
 )
 
 func TestGenerateTelemetry(t *testing.T) {
@@ -62,7 +64,7 @@ func TestPatrolMovement(t *testing.T) {
 		Position: Position{Lat: 48.2082, Lon: 16.3738, Alt: 100},
 	}
 	strategy := PatrolMovement{}
-	newPos := strategy.Move(drone, region, nil, rand.New(rand.NewSource(1)))
+	newPos := strategy.Move(drone, region, nil, time.Second, rand.New(rand.NewSource(1)))
 	distance := calculateDistance(region.CenterLat, region.CenterLon, newPos.Lat, newPos.Lon)
 	if distance > region.RadiusKM*1000 {
 		t.Errorf("Patrol movement exceeded region radius: got %f, expected <= %f", distance, region.RadiusKM*1000)
@@ -78,7 +80,7 @@ func TestPointToPointMovement(t *testing.T) {
 		Position: Position{Lat: 48.2082, Lon: 16.3738, Alt: 100},
 	}
 	strategy := PointToPointMovement{}
-	newPos := strategy.Move(drone, Region{}, waypoints, rand.New(rand.NewSource(1)))
+	newPos := strategy.Move(drone, Region{}, waypoints, time.Second, rand.New(rand.NewSource(1)))
 
 	closestWaypoint := findClosestWaypoint(newPos, waypoints)
 	distanceToWaypoint := calculateDistance(newPos.Lat, newPos.Lon, closestWaypoint.Lat, closestWaypoint.Lon)
@@ -87,6 +89,55 @@ func TestPointToPointMovement(t *testing.T) {
 	}
 }
 
+func TestPointToPointRouteModes(t *testing.T) {
+	waypoints := []Position{
+		{Lat: 48.2082, Lon: 16.3738, Alt: 100},
+		{Lat: 48.2092, Lon: 16.3738, Alt: 120},
+		{Lat: 48.2102, Lon: 16.3738, Alt: 140},
+	}
+	cases := map[string][]int{
+		RouteLoop:     {1, 2, 0, 1},
+		RoutePingPong: {1, 2, 1, 0, 1},
+		RouteOneWay:   {1, 2, 2, 2},
+	}
+	for mode, want := range cases {
+		drone := &Drone{
+			Position:  waypoints[0],
+			Waypoints: waypoints,
+			RouteMode: mode,
+			Behavior:  Behavior{SpeedMinKmh: 36, SpeedMaxKmh: 36},
+		}
+		r := rand.New(rand.NewSource(1))
+		for i, idx := range want {
+			drone.Position = PointToPointMovement{}.Move(drone, Region{}, waypoints, 20*time.Second, r)
+			if drone.WaypointIndex != idx {
+				t.Fatalf("%s: step %d expected waypoint %d, got %d", mode, i, idx, drone.WaypointIndex)
+			}
+		}
+	}
+}
+
+func TestPointToPointHoldsSpeed(t *testing.T) {
+	gen := NewGenerator("c", rand.New(rand.NewSource(1)), nil)
+	target := Position{Lat: 48.2182, Lon: 16.3738, Alt: 200}
+	drone := &Drone{
+		MovementPattern: "point-to-point",
+		Battery:         100,
+		Position:        Position{Lat: 48.2082, Lon: 16.3738, Alt: 100},
+		Waypoints:       []Position{target},
+		Behavior:        Behavior{SpeedMinKmh: 36, SpeedMaxKmh: 36},
+		SpeedMPS:        10, // already cruising north
+	}
+	prev := drone.Position
+	row := gen.GenerateTelemetry(drone, prev, time.Second)
+	if math.Abs(row.SpeedMPS-10) > 0.1 {
+		t.Fatalf("expected 10 m/s towards the waypoint, got %.2f", row.SpeedMPS)
+	}
+	if row.Alt <= prev.Alt || row.WaypointIndex != 0 {
+		t.Fatalf("expected climb towards waypoint 0, got alt %.2f index %d", row.Alt, row.WaypointIndex)
+	}
+}
+
 func TestLoiterMovement(t *testing.T) {
 	region := Region{
 		Name:      "test-region",
@@ -98,20 +149,36 @@ func TestLoiterMovement(t *testing.T) {
 		Position: Position{Lat: 48.2082, Lon: 16.3738, Alt: 100},
 	}
 	strategy := LoiterMovement{}
-	newPos := strategy.Move(drone, region, nil, rand.New(rand.NewSource(1)))
+	newPos := strategy.Move(drone, region, nil, time.Second, rand.New(rand.NewSource(1)))
 	distance := calculateDistance(region.CenterLat, region.CenterLon, newPos.Lat, newPos.Lon)
 	if distance > 10 {
 		t.Errorf("Loiter movement exceeded allowed range: got %f, expected <= 10", distance)
 	}
 }
 
+func TestLoiterJitterInMetersAtHighLatitude(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, lat := range []float64{0, 60, 80} {
+		region := Region{CenterLat: lat, CenterLon: 16, RadiusKM: 1}
+		var north, east float64
+		for i := 0; i < 200; i++ {
+			goal := loiterGoal(region, r)
+			north = math.Max(north, geo.Distance(lat, 16, goal.Lat, 16))
+			east = math.Max(east, geo.Distance(lat, 16, lat, goal.Lon))
+		}
+		if north > loiterJitterM+0.01 || east > loiterJitterM+0.01 || north < loiterJitterM*0.8 || east < loiterJitterM*0.8 {
+			t.Errorf("lat %v: expected jitter up to %v m both ways, got %.2f m north and %.2f m east", lat, loiterJitterM, north, east)
+		}
+	}
+}
+
 func TestRandomWalkMovement(t *testing.T) {
 	drone := &Drone{
 		Model:    "medium-uav",
 		Position: Position{Lat: 48.2082, Lon: 16.3738, Alt: 10},
 	}
 	strategy := RandomWalkMovement{}
-	newPos := strategy.Move(drone, Region{}, nil, rand.New(rand.NewSource(1)))
+	newPos := strategy.Move(drone, Region{}, nil, time.Second, rand.New(rand.NewSource(1)))
 	if newPos.Alt < 0 {
 		t.Errorf("altitude should not be negative: %f", newPos.Alt)
 	}
@@ -120,6 +187,35 @@ func TestRandomWalkMovement(t *testing.T) {
 	}
 }
 
+func TestRandomWalkTurnsBackIntoConcaveRegion(t *testing.T) {
+	region := uRegion()
+	drone := &Drone{Model: "medium-uav", Position: Position{Lat: 0.025, Lon: 0.015, Alt: 100}} // In the notch
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 120 && !region.Contains(drone.Position.Lat, drone.Position.Lon); i++ {
+		drone.Position = RandomWalkMovement{}.Move(drone, region, nil, time.Second, r)
+	}
+	if !region.Contains(drone.Position.Lat, drone.Position.Lon) {
+		t.Fatalf("expected the drone to find its way back into the U, got %+v", drone.Position)
+	}
+}
+
+func TestEscortMovementHoldsFormationSlot(t *testing.T) {
+	g := NewGenerator("c", rand.New(rand.NewSource(1)), nil)
+	slot := Position{Lat: 48.2085, Lon: 16.3738}
+	drone := &Drone{
+		Model:           "small-fpv",
+		Battery:         100,
+		MovementPattern: "escort",
+		Position:        Position{Lat: 48.2082, Lon: 16.3738, Alt: 100},
+		FormationSlot:   &slot,
+	}
+	before := calculateDistance(drone.Position.Lat, drone.Position.Lon, slot.Lat, slot.Lon)
+	g.GenerateTelemetry(drone, drone.Position, time.Second)
+	if after := calculateDistance(drone.Position.Lat, drone.Position.Lon, slot.Lat, slot.Lon); after >= before {
+		t.Errorf("expected escort to close on its slot, %f -> %f", before, after)
+	}
+}
+
 func TestSpeedAndHeadingPatrol(t *testing.T) { testSpeedAndHeading(t, "patrol", nil) }
 func TestSpeedAndHeadingPointToPoint(t *testing.T) {
 	wps := []Position{{Lat: 48.2083, Lon: 16.3740}}
@@ -134,7 +230,7 @@ func testSpeedAndHeading(t *testing.T, pattern string, wps []Position) {
 	prev := drone.Position
 	row := gen.GenerateTelemetry(drone, prev, time.Second)
 	expSpeed := calculateDistance(prev.Lat, prev.Lon, row.Lat, row.Lon)
-	expHeading := bearingDegrees(prev.Lat, prev.Lon, row.Lat, row.Lon)
+	expHeading := geo.Bearing(prev.Lat, prev.Lon, row.Lat, row.Lon)
 	if math.Abs(row.SpeedMPS-expSpeed) > 1e-6 {
 		t.Errorf("speed mismatch: got %.6f want %.6f", row.SpeedMPS, expSpeed)
 	}
@@ -157,6 +253,72 @@ func TestBatteryDrain(t *testing.T) {
 	}
 }
 
+func TestBehaviorSpeedBand(t *testing.T) {
+	region := Region{CenterLat: 48.2082, CenterLon: 16.3738, RadiusKM: 5}
+	strategies := map[string]MovementStrategy{
+		"patrol":         PatrolMovement{},
+		"point-to-point": PointToPointMovement{},
+		"random":         RandomWalkMovement{},
+		"follow":         FollowMovement{Target: Position{Lat: 48.25, Lon: 16.3738}},
+	}
+	for name, strategy := range strategies {
+		drone := &Drone{
+			Model:    "small-fpv",
+			Position: Position{Lat: 48.2082, Lon: 16.3738, Alt: 100},
+			Behavior: Behavior{SpeedMinKmh: 36, SpeedMaxKmh: 36},
+		}
+		wps := []Position{{Lat: 48.25, Lon: 16.3738}}
+		newPos := strategy.Move(drone, region, wps, 2*time.Second, rand.New(rand.NewSource(1)))
+		d := calculateDistance(drone.Position.Lat, drone.Position.Lon, newPos.Lat, newPos.Lon)
+		if math.Abs(d-20) > 0.5 {
+			t.Errorf("%s: expected 20m at 36 km/h over 2s, got %.2f", name, d)
+		}
+	}
+}
+
+func TestBehaviorDrainAndFailure(t *testing.T) {
+	gen := NewGenerator("c", rand.New(rand.NewSource(1)), nil)
+	drone := &Drone{
+		Model:    "small-fpv",
+		Battery:  50,
+		Position: Position{Lat: 48.2082, Lon: 16.3738, Alt: 100},
+		Behavior: Behavior{BatteryDrainRate: 1.5},
+	}
+	gen.GenerateTelemetry(drone, drone.Position, 2*time.Second)
+	if drone.Battery != 47 || drone.Status != StatusOK {
+		t.Fatalf("expected 3%% drain without failure, got battery %.2f status %s", drone.Battery, drone.Status)
+	}
+	drone.Behavior.FailureRate = 1
+	if row := gen.GenerateTelemetry(drone, drone.Position, time.Second); row.Status != StatusFailure || !drone.Crashed {
+		t.Fatalf("expected injected failure, got %s", row.Status)
+	}
+
+	// The failure sticks: the wreck stays down without moving or draining
+	drone.Behavior.FailureRate = 0
+	gen.GenerateTelemetry(drone, drone.Position, time.Second)
+	wreck, battery := drone.Position, drone.Battery
+	for i := 0; i < 5; i++ {
+		if row := gen.GenerateTelemetry(drone, drone.Position, time.Second); row.Status != StatusFailure {
+			t.Fatalf("expected the failure to persist, got %s", row.Status)
+		}
+	}
+	if drone.Position != wreck || drone.Position.Alt != 0 || drone.Battery != battery {
+		t.Fatalf("expected the wreck to stay on the ground, got %+v battery %.2f", drone.Position, drone.Battery)
+	}
+}
+
+func TestFailureChanceScalesWithDt(t *testing.T) {
+	drone := &Drone{Behavior: Behavior{FailureRate: 0.1}}
+	second, tenth := failureChance(drone, time.Second), failureChance(drone, 100*time.Millisecond)
+	if math.Abs(second-0.1) > 1e-9 || math.Abs(1-math.Pow(1-tenth, 10)-0.1) > 1e-9 {
+		t.Fatalf("expected the same rate per second at any tick, got %v and %v", second, tenth)
+	}
+	drone.Phase = PhaseIdle
+	if c := failureChance(drone, time.Second); c != 0 {
+		t.Fatalf("expected drones on the ground not to fail, got %v", c)
+	}
+}
+
 func TestTelemetryRowTableName(t *testing.T) {
 	orig := TelemetryTableName
 	TelemetryTableName = "custom"
@@ -0,0 +1,92 @@
+package telemetry
+
+import (
+	"math"
+	"time"
+
+	"droneops-sim/internal/geo"
+)
+
+// Limits bounds how quickly a drone can change its kinematic state and how
+// close it may come to other drones.
+type Limits struct {
+	MaxAccelMPS2    float64 // Maximum change of ground speed per second
+	MaxTurnRateDegS float64 // Maximum change of heading per second
+	MaxClimbRateMPS float64 // Maximum vertical speed, up or down
+	MinSeparationM  float64 // Minimum distance kept to other drones
+}
+
+// ModelLimits returns the kinematic limits of a drone model.
+func ModelLimits(model string) Limits {
+	switch model {
+	case "small-fpv":
+		return Limits{MaxAccelMPS2: 8, MaxTurnRateDegS: 90, MaxClimbRateMPS: 8, MinSeparationM: 5}
+	case "medium-uav":
+		return Limits{MaxAccelMPS2: 4, MaxTurnRateDegS: 45, MaxClimbRateMPS: 5, MinSeparationM: 15}
+	case "large-uav":
+		return Limits{MaxAccelMPS2: 2, MaxTurnRateDegS: 20, MaxClimbRateMPS: 3, MinSeparationM: 30}
+	default:
+		return Limits{MaxAccelMPS2: 4, MaxTurnRateDegS: 45, MaxClimbRateMPS: 5, MinSeparationM: 15}
+	}
+}
+
+// steer turns, accelerates and climbs the drone toward goal, cruising at up
+// to speed m/s within its model limits, and returns the position after dt.
+// The drone slows down on approach so it can stop at the goal.
+func steer(drone *Drone, goal Position, speed float64, dt time.Duration) Position {
+	secs := dt.Seconds()
+	if secs <= 0 {
+		return drone.Position
+	}
+	lim := ModelLimits(drone.Model)
+	pos := drone.Position
+	dist := geo.Distance(pos.Lat, pos.Lon, goal.Lat, goal.Lon)
+
+	// Turn toward the goal
+	errDeg := 0.0
+	if dist > 0 {
+		errDeg = angleDiff(geo.Bearing(pos.Lat, pos.Lon, goal.Lat, goal.Lon), drone.HeadingDeg)
+		turn := clamp(errDeg, lim.MaxTurnRateDegS*secs)
+		drone.HeadingDeg = math.Mod(drone.HeadingDeg+turn+360, 360)
+		errDeg -= turn
+	}
+
+	// Accelerate toward the desired speed, braking for the goal and for
+	// sharp turns still to be flown
+	desired := math.Min(speed, math.Min(math.Sqrt(2*lim.MaxAccelMPS2*dist), dist/secs))
+	desired *= math.Max(0, math.Cos(errDeg*math.Pi/180))
+	drone.SpeedMPS += clamp(desired-drone.SpeedMPS, lim.MaxAccelMPS2*secs)
+	if drone.SpeedMPS < 0 {
+		drone.SpeedMPS = 0
+	}
+
+	// Climb toward the goal altitude
+	drone.ClimbRateMPS = clamp((goal.Alt-pos.Alt)/secs, lim.MaxClimbRateMPS)
+
+	// Never below the ground
+	next := ahead(pos, drone.HeadingDeg, drone.SpeedMPS*secs)
+	next.Alt = math.Max(drone.Ground(next), pos.Alt+drone.ClimbRateMPS*secs)
+	return next
+}
+
+// ahead returns the point dist meters from pos along heading, in degrees from north.
+func ahead(pos Position, heading, dist float64) Position {
+	lat, lon := geo.Destination(pos.Lat, pos.Lon, heading, dist)
+	return Position{Lat: lat, Lon: lon, Alt: pos.Alt}
+}
+
+// angleDiff returns the signed difference a-b in degrees, within (-180, 180].
+func angleDiff(a, b float64) float64 {
+	d := math.Mod(a-b, 360)
+	if d > 180 {
+		d -= 360
+	} else if d <= -180 {
+		d += 360
+	}
+	return d
+}
+
+// clamp limits v to [-limit, limit].
+func clamp(v, limit float64) float64 {
+	return math.Max(-limit, math.Min(limit, v))
+}
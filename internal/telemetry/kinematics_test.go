@@ -0,0 +1,71 @@
+package telemetry
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+	"time"
+
+	"droneops-sim/internal/geo"
+)
+
+func TestSteerRespectsLimits(t *testing.T) {
+	lim := ModelLimits("medium-uav")
+	drone := &Drone{Model: "medium-uav", Position: Position{Lat: 48.2, Lon: 16.4, Alt: 100}}
+	goal := Position{Lat: 48.1, Lon: 16.39, Alt: 300} // far to the south-southwest and above
+
+	drone.Position = steer(drone, goal, 40, time.Second)
+	if drone.SpeedMPS > lim.MaxAccelMPS2 || math.Abs(drone.HeadingDeg-(360-lim.MaxTurnRateDegS)) > 1e-9 {
+		t.Fatalf("expected limited acceleration and turn, got speed %.2f heading %.2f", drone.SpeedMPS, drone.HeadingDeg)
+	}
+	if drone.ClimbRateMPS != lim.MaxClimbRateMPS || drone.Position.Alt != 100+lim.MaxClimbRateMPS {
+		t.Fatalf("expected limited climb, got rate %.2f alt %.2f", drone.ClimbRateMPS, drone.Position.Alt)
+	}
+	for i := 0; i < 30; i++ {
+		drone.Position = steer(drone, goal, 40, time.Second)
+	}
+	want := geo.Bearing(drone.Position.Lat, drone.Position.Lon, goal.Lat, goal.Lon)
+	if math.Abs(drone.SpeedMPS-40) > 1e-9 || math.Abs(drone.HeadingDeg-want) > 0.1 {
+		t.Fatalf("expected cruise toward goal at 40 m/s, got speed %.2f heading %.2f", drone.SpeedMPS, drone.HeadingDeg)
+	}
+}
+
+func TestSteerStopsAtGoal(t *testing.T) {
+	drone := &Drone{Model: "small-fpv", Position: Position{Lat: 48.2, Lon: 16.4}, SpeedMPS: 20}
+	goal := Position{Lat: 48.201, Lon: 16.4}
+	for i := 0; i < 30; i++ {
+		drone.Position = steer(drone, goal, 20, time.Second)
+	}
+	if d := geo.Distance(drone.Position.Lat, drone.Position.Lon, goal.Lat, goal.Lon); d > 1 || drone.SpeedMPS > 1 {
+		t.Fatalf("expected drone to stop at goal, got %.2f m at %.2f m/s", d, drone.SpeedMPS)
+	}
+}
+
+func TestMovementIsContinuous(t *testing.T) {
+	lim := ModelLimits("medium-uav")
+	for _, pattern := range []string{"patrol", "loiter", "random"} {
+		gen := NewGenerator("c", rand.New(rand.NewSource(1)), nil)
+		drone := &Drone{
+			Model:           "medium-uav",
+			MovementPattern: pattern,
+			Battery:         100,
+			Position:        Position{Lat: 48.2, Lon: 16.4, Alt: 100},
+			HomeRegion:      Region{CenterLat: 48.2, CenterLon: 16.4, RadiusKM: 2},
+		}
+		_, speedMax := speedBand(drone)
+		var last TelemetryRow
+		for i := 0; i < 120; i++ {
+			row := gen.GenerateTelemetry(drone, drone.Position, time.Second)
+			if row.SpeedMPS > speedMax+0.01 {
+				t.Fatalf("%s: speed %.2f above band maximum %.2f", pattern, row.SpeedMPS, speedMax)
+			}
+			if i > 0 && math.Abs(row.SpeedMPS-last.SpeedMPS) > lim.MaxAccelMPS2+0.01 {
+				t.Fatalf("%s: speed jumped from %.2f to %.2f", pattern, last.SpeedMPS, row.SpeedMPS)
+			}
+			if i > 0 && row.SpeedMPS > 1 && last.SpeedMPS > 1 && math.Abs(angleDiff(row.HeadingDeg, last.HeadingDeg)) > lim.MaxTurnRateDegS+0.01 {
+				t.Fatalf("%s: heading jumped from %.2f to %.2f", pattern, last.HeadingDeg, row.HeadingDeg)
+			}
+			last = row
+		}
+	}
+}
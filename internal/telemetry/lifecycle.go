@@ -0,0 +1,101 @@
+package telemetry
+
+import (
+	"math"
+	"math/rand"
+	"time"
+
+	"droneops-sim/internal/geo"
+)
+
+// advancePhase moves the drone to its next lifecycle phase once the current
+// one is complete, and sends it home when its battery reaches the return
+// reserve. Idle drones stay on the ground until they are launched by setting
+// the takeoff phase. Drones without a phase have no lifecycle, crashed drones
+// keep the phase they crashed in.
+func advancePhase(drone *Drone) {
+	if drone.Crashed {
+		return
+	}
+	switch drone.Phase {
+	case PhaseTakeoff:
+		if drone.Position.Alt >= cruiseAlt(drone)-0.5 {
+			drone.Phase = PhaseTransit
+		}
+	case PhaseTransit:
+		if inRegion(drone.Position, drone.HomeRegion) {
+			drone.Phase = PhaseOnStation
+		}
+	case PhaseReturnToBase:
+		if geo.Distance(drone.Position.Lat, drone.Position.Lon, drone.Base.Lat, drone.Base.Lon) <= DefaultArrivalRadiusM {
+			drone.Phase = PhaseLanding
+		}
+	case PhaseLanding:
+		if drone.Position.Alt <= drone.Base.Alt+0.5 {
+			drone.Phase = PhaseIdle
+			drone.Position = drone.Base
+			drone.SpeedMPS, drone.ClimbRateMPS = 0, 0
+		}
+	}
+	if (drone.Phase == PhaseTransit || drone.Phase == PhaseOnStation) && drone.Battery <= returnReserve(drone) {
+		drone.Phase = PhaseReturnToBase
+	}
+}
+
+// LifecycleMovement flies the takeoff, transit, return and landing legs and
+// keeps idle drones on the ground.
+type LifecycleMovement struct{}
+
+func (l LifecycleMovement) Move(drone *Drone, region Region, waypoints []Position, dt time.Duration, r *rand.Rand) Position {
+	_, speedMax := speedBand(drone)
+	cruise := cruiseAlt(drone)
+	switch drone.Phase {
+	case PhaseTakeoff:
+		return steer(drone, Position{Lat: drone.Position.Lat, Lon: drone.Position.Lon, Alt: cruise}, 0, dt)
+	case PhaseTransit:
+		lat, lon := region.Anchor()
+		return steer(drone, Position{Lat: lat, Lon: lon, Alt: cruise}, speedMax, dt)
+	case PhaseReturnToBase:
+		return steer(drone, Position{Lat: drone.Base.Lat, Lon: drone.Base.Lon, Alt: cruise}, speedMax, dt)
+	case PhaseLanding:
+		return steer(drone, drone.Base, 0, dt)
+	default:
+		drone.SpeedMPS, drone.ClimbRateMPS = 0, 0
+		return drone.Position
+	}
+}
+
+// returnReserve is the battery level at which a drone has to head home: the
+// charge needed to fly back at its slowest cruise speed and descend, with a
+// safety margin, on top of the failure threshold.
+func returnReserve(drone *Drone) float64 {
+	speedMin, _ := speedBand(drone)
+	home := geo.Distance(drone.Position.Lat, drone.Position.Lon, drone.Base.Lat, drone.Base.Lon)
+	descent := math.Max(0, drone.Position.Alt-drone.Base.Alt) / ModelLimits(drone.Model).MaxClimbRateMPS
+	course := geo.Bearing(drone.Position.Lat, drone.Position.Lon, drone.Base.Lat, drone.Base.Lon)
+	return BatteryFailureThreshold + ReturnReserveMargin*(home/speedMin+descent)*drainRate(drone)*windDrain(drone, course)
+}
+
+// cruiseAlt returns the drone's cruise altitude above mean sea level.
+// Terrain-following drones cruise at their cruise height above the ground
+// under them.
+func cruiseAlt(drone *Drone) float64 {
+	if drone.AltitudeMode == AltitudeTerrainFollowing {
+		return drone.Ground(drone.Position) + cruiseHeight(drone)
+	}
+	return cruiseHeight(drone)
+}
+
+// cruiseHeight returns the configured cruise altitude, defaulting to
+// DefaultCruiseAltM.
+func cruiseHeight(drone *Drone) float64 {
+	if drone.CruiseAltM > 0 {
+		return drone.CruiseAltM
+	}
+	return DefaultCruiseAltM
+}
+
+// inRegion reports whether pos lies within the region, or near its center.
+func inRegion(pos Position, region Region) bool {
+	return region.Contains(pos.Lat, pos.Lon) || geo.Distance(pos.Lat, pos.Lon, region.CenterLat, region.CenterLon) <= DefaultArrivalRadiusM
+}
@@ -0,0 +1,77 @@
+package telemetry
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+)
+
+func TestLifecycleFullSortie(t *testing.T) {
+	gen := NewGenerator("c", rand.New(rand.NewSource(1)), nil)
+	base := Position{Lat: 48.2, Lon: 16.4}
+	drone := &Drone{
+		Model:           "small-fpv",
+		MovementPattern: "loiter",
+		Battery:         100,
+		Phase:           PhaseTakeoff,
+		Base:            base,
+		Position:        base,
+		HomeRegion:      Region{CenterLat: 48.21, CenterLon: 16.4, RadiusKM: 0.5},
+	}
+	want := []string{PhaseTakeoff, PhaseTransit, PhaseOnStation, PhaseReturnToBase, PhaseLanding, PhaseIdle}
+	var got []string
+	for i := 0; i < 600 && len(got) < len(want); i++ {
+		row := gen.GenerateTelemetry(drone, drone.Position, time.Second)
+		if len(got) == 0 || got[len(got)-1] != row.Phase {
+			got = append(got, row.Phase)
+		}
+		if row.Status == StatusFailure {
+			t.Fatalf("drone failed in phase %s with battery %.1f", row.Phase, row.Battery)
+		}
+	}
+	if len(got) != len(want) {
+		t.Fatalf("expected phases %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("expected phases %v, got %v", want, got)
+		}
+	}
+	if drone.Position != base || drone.SpeedMPS != 0 {
+		t.Fatalf("expected drone parked at base, got %+v at %.2f m/s", drone.Position, drone.SpeedMPS)
+	}
+	drone.Battery = 100
+	gen.GenerateTelemetry(drone, drone.Position, time.Second)
+	if drone.Phase != PhaseIdle || drone.Battery != 100 {
+		t.Fatalf("expected idle drone to stay powered down until launched, got %s %.2f", drone.Phase, drone.Battery)
+	}
+}
+
+func TestReturnReserveGrowsWithDistance(t *testing.T) {
+	drone := &Drone{Model: "small-fpv", Position: Position{Lat: 48.2, Lon: 16.4, Alt: 100}, Base: Position{Lat: 48.2, Lon: 16.4}}
+	near := returnReserve(drone)
+	drone.Position.Lat += 0.02
+	far := returnReserve(drone)
+	if near <= BatteryFailureThreshold || far <= near {
+		t.Fatalf("expected reserve to grow with distance, got %.2f near and %.2f far", near, far)
+	}
+	drone.Wind = Wind{DirectionDeg: 180, SpeedMPS: 10} // Blowing from the base
+	if headwind := returnReserve(drone); headwind <= far {
+		t.Fatalf("expected a headwind home to raise the reserve, got %.2f in calm and %.2f into the wind", far, headwind)
+	}
+}
+
+func TestFlatBatteryBringsDroneDown(t *testing.T) {
+	gen := NewGenerator("c", rand.New(rand.NewSource(1)), nil)
+	base := Position{Lat: 48.2, Lon: 16.4}
+	drone := &Drone{Model: "small-fpv", Position: Position{Lat: 48.21, Lon: 16.4, Alt: 100}, Base: base, Phase: PhaseReturnToBase, Battery: 0.1}
+	gen.GenerateTelemetry(drone, drone.Position, time.Second)
+	if !drone.Crashed || drone.Status != StatusFailure {
+		t.Fatalf("expected a drone running flat in the air to crash, got %+v", drone)
+	}
+	idle := &Drone{Model: "small-fpv", Position: base, Base: base, Phase: PhaseIdle}
+	gen.GenerateTelemetry(idle, base, time.Second)
+	if idle.Crashed {
+		t.Fatalf("expected a flat drone on the ground to wait for a charge")
+	}
+}
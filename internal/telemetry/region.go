@@ -0,0 +1,21 @@
+package telemetry
+
+import "droneops-sim/internal/geo"
+
+// Contains reports whether lat, lon lies within the region: inside its
+// polygons, or within RadiusKM of the center for circular regions.
+func (r Region) Contains(lat, lon float64) bool {
+	if r.Shape != nil {
+		return r.Shape.Contains(lat, lon)
+	}
+	return geo.Distance(lat, lon, r.CenterLat, r.CenterLon) <= r.RadiusKM*1000
+}
+
+// Anchor returns the center of the region, or a point inside its polygons
+// if the center lies outside them.
+func (r Region) Anchor() (lat, lon float64) {
+	if r.Shape == nil || r.Shape.Contains(r.CenterLat, r.CenterLon) {
+		return r.CenterLat, r.CenterLon
+	}
+	return r.Shape.InteriorPoint()
+}
@@ -0,0 +1,213 @@
+package telemetry
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ParamType is the type of a movement pattern parameter.
+type ParamType string
+
+const (
+	ParamNumber ParamType = "number"
+	ParamInt    ParamType = "int"
+	ParamString ParamType = "string"
+	ParamBool   ParamType = "bool"
+)
+
+// Param declares a typed parameter of a movement pattern. Constraint is an
+// optional CUE expression that narrows the value, e.g. ">0 & <=1".
+type Param struct {
+	Name       string
+	Type       ParamType
+	Constraint string
+}
+
+// Params holds the parameter values of a fleet's movement pattern by name.
+type Params map[string]any
+
+// Float returns the named number parameter, or def if it is not set.
+func (p Params) Float(name string, def float64) float64 {
+	switch v := p[name].(type) {
+	case float64:
+		return v
+	case int:
+		return float64(v)
+	}
+	return def
+}
+
+// Int returns the named integer parameter, or def if it is not set.
+func (p Params) Int(name string, def int) int {
+	switch v := p[name].(type) {
+	case int:
+		return v
+	case float64:
+		return int(v)
+	}
+	return def
+}
+
+// String returns the named string parameter, or def if it is not set.
+func (p Params) String(name, def string) string {
+	if v, ok := p[name].(string); ok {
+		return v
+	}
+	return def
+}
+
+// Bool returns the named bool parameter, or def if it is not set.
+func (p Params) Bool(name string, def bool) bool {
+	if v, ok := p[name].(bool); ok {
+		return v
+	}
+	return def
+}
+
+// Pattern is a named movement pattern. New builds the strategy flying the
+// pattern with a fleet's parameters; unset parameters take their defaults.
+type Pattern struct {
+	Name   string
+	Params []Param
+	New    func(Params) MovementStrategy
+}
+
+var (
+	patternsMu sync.RWMutex
+	patterns   = make(map[string]Pattern)
+)
+
+// RegisterPattern makes a movement pattern available to fleets by name. It
+// panics if the name is empty or taken, or the pattern has no constructor.
+func RegisterPattern(p Pattern) {
+	patternsMu.Lock()
+	defer patternsMu.Unlock()
+	if p.Name == "" || p.New == nil {
+		panic("telemetry: pattern needs a name and a constructor")
+	}
+	if _, dup := patterns[p.Name]; dup {
+		panic("telemetry: pattern " + p.Name + " registered twice")
+	}
+	patterns[p.Name] = p
+}
+
+// LookupPattern returns the registered pattern of the given name.
+func LookupPattern(name string) (Pattern, bool) {
+	patternsMu.RLock()
+	defer patternsMu.RUnlock()
+	p, ok := patterns[name]
+	return p, ok
+}
+
+// PatternNames returns the names of all registered patterns in order.
+func PatternNames() []string {
+	patternsMu.RLock()
+	defer patternsMu.RUnlock()
+	names := make([]string, 0, len(patterns))
+	for name := range patterns {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// NewStrategy builds the strategy of the named pattern. Unknown patterns
+// walk randomly.
+func NewStrategy(name string, params Params) MovementStrategy {
+	if p, ok := LookupPattern(name); ok {
+		return p.New(params)
+	}
+	return RandomWalkMovement{}
+}
+
+// ValidateParams checks that params only sets declared parameters of the
+// named pattern, with values of their type.
+func ValidateParams(name string, params Params) error {
+	p, ok := LookupPattern(name)
+	if !ok {
+		return fmt.Errorf("unknown movement pattern %q", name)
+	}
+	types := make(map[string]ParamType, len(p.Params))
+	for _, param := range p.Params {
+		types[param.Name] = param.Type
+	}
+	for key, v := range params {
+		typ, ok := types[key]
+		if !ok {
+			return fmt.Errorf("pattern %s has no parameter %q", name, key)
+		}
+		valid := false
+		switch v.(type) {
+		case float64:
+			valid = typ == ParamNumber
+		case int:
+			valid = typ == ParamNumber || typ == ParamInt
+		case string:
+			valid = typ == ParamString
+		case bool:
+			valid = typ == ParamBool
+		}
+		if !valid {
+			return fmt.Errorf("pattern %s parameter %q must be a %s, got %v", name, key, typ, v)
+		}
+	}
+	return nil
+}
+
+// PatternSchema returns the CUE definitions of the registered patterns:
+// #MovementPattern, the pattern names, and #PatternParams, the closed
+// parameters of each pattern. They complete the simulation schema.
+func PatternSchema() string {
+	var b strings.Builder
+	names := PatternNames()
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = strconv.Quote(name)
+	}
+	fmt.Fprintf(&b, "#MovementPattern: %s\n", strings.Join(quoted, " | "))
+	b.WriteString("#PatternParams: {\n")
+	for _, name := range names {
+		p, _ := LookupPattern(name)
+		fmt.Fprintf(&b, "\t%s: {", strconv.Quote(name))
+		for _, param := range p.Params {
+			c := string(param.Type)
+			if param.Constraint != "" {
+				c += " & " + param.Constraint
+			}
+			fmt.Fprintf(&b, "\n\t\t%s?: %s", strconv.Quote(param.Name), c)
+		}
+		if len(p.Params) > 0 {
+			b.WriteString("\n\t")
+		}
+		b.WriteString("}\n")
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// The built-in movement patterns.
+func init() {
+	RegisterPattern(Pattern{
+		Name:   "patrol",
+		Params: []Param{{Name: "radius_scale", Type: ParamNumber, Constraint: ">0 & <=1"}},
+		New: func(p Params) MovementStrategy {
+			return PatrolMovement{RadiusScale: p.Float("radius_scale", 0)}
+		},
+	})
+	RegisterPattern(Pattern{Name: "point-to-point", New: func(Params) MovementStrategy { return PointToPointMovement{} }})
+	RegisterPattern(Pattern{Name: "loiter", New: func(Params) MovementStrategy { return LoiterMovement{} }})
+	// Escorts hold a formation slot; without one they loiter
+	RegisterPattern(Pattern{Name: "escort", New: func(Params) MovementStrategy { return LoiterMovement{} }})
+	// Search drones fly the waypoints of their search lane
+	RegisterPattern(Pattern{Name: "search", New: func(Params) MovementStrategy { return PointToPointMovement{} }})
+	RegisterPattern(Pattern{
+		Name:   "random-walk",
+		Params: []Param{{Name: "max_turn_deg", Type: ParamNumber, Constraint: ">0 & <=180"}},
+		New: func(p Params) MovementStrategy {
+			return RandomWalkMovement{MaxTurnDeg: p.Float("max_turn_deg", 0)}
+		},
+	})
+}
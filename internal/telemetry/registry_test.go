@@ -0,0 +1,69 @@
+package telemetry
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+)
+
+type hoverMovement struct{ altM float64 }
+
+func (h hoverMovement) Move(drone *Drone, region Region, waypoints []Position, dt time.Duration, r *rand.Rand) Position {
+	return Position{Lat: drone.Position.Lat, Lon: drone.Position.Lon, Alt: h.altM}
+}
+
+func TestRegisteredPatternDrivesGenerator(t *testing.T) {
+	RegisterPattern(Pattern{
+		Name:   "registry-test-hover",
+		Params: []Param{{Name: "alt_m", Type: ParamNumber, Constraint: ">0"}},
+		New: func(p Params) MovementStrategy {
+			return hoverMovement{altM: p.Float("alt_m", 10)}
+		},
+	})
+	gen := NewGenerator("c", rand.New(rand.NewSource(1)), nil)
+	drone := &Drone{
+		MovementPattern: "registry-test-hover",
+		PatternParams:   Params{"alt_m": 42},
+		Position:        Position{Lat: 48.2, Lon: 16.4, Alt: 100},
+		Battery:         100,
+	}
+	if row := gen.GenerateTelemetry(drone, drone.Position, time.Second); row.Alt != 42 {
+		t.Fatalf("expected custom pattern with its parameter, got %+v", row)
+	}
+	if !strings.Contains(PatternSchema(), `"registry-test-hover": {
+		"alt_m"?: number & >0
+	}`) {
+		t.Fatalf("expected pattern and parameter in schema, got\n%s", PatternSchema())
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected duplicate registration to panic")
+		}
+	}()
+	RegisterPattern(Pattern{Name: "registry-test-hover", New: func(Params) MovementStrategy { return LoiterMovement{} }})
+}
+
+func TestValidateParams(t *testing.T) {
+	cases := []struct {
+		pattern string
+		params  Params
+		valid   bool
+	}{
+		{"patrol", nil, true},
+		{"patrol", Params{"radius_scale": 0.5}, true},
+		{"patrol", Params{"radius_scale": 1}, true},
+		{"patrol", Params{"radius_scale": "wide"}, false},
+		{"loiter", Params{"radius_scale": 0.5}, false},
+		{"unknown", nil, false},
+	}
+	for _, c := range cases {
+		if err := ValidateParams(c.pattern, c.params); (err == nil) != c.valid {
+			t.Errorf("%s %v: expected valid=%t, got %v", c.pattern, c.params, c.valid, err)
+		}
+	}
+	if s := NewStrategy("unknown", nil); s != (RandomWalkMovement{}) {
+		t.Errorf("expected random walk for unknown pattern, got %T", s)
+	}
+}
@@ -0,0 +1,20 @@
+package telemetry
+
+import "time"
+
+// Scenario phase transition kinds.
+const (
+	PhaseTransitionEnter = "enter"
+	PhaseTransitionExit  = "exit"
+)
+
+// ScenarioPhaseRow records a scenario phase being entered or exited.
+type ScenarioPhaseRow struct {
+	ClusterID  string    `json:"cluster_id"`
+	Scenario   string    `json:"scenario"`
+	Phase      string    `json:"phase"`
+	Transition string    `json:"transition"`
+	Event      string    `json:"event,omitempty"`
+	Value      int       `json:"value"`
+	Timestamp  time.Time `json:"ts"`
+}
@@ -0,0 +1,127 @@
+package telemetry
+
+import (
+	"math"
+
+	"droneops-sim/internal/geo"
+)
+
+// Search patterns of the search movement pattern.
+const (
+	SearchLawnmower       = "lawnmower"        // Parallel north-south legs across the drone's lane
+	SearchExpandingSquare = "expanding_square" // Square spiral out of the region center
+)
+
+// DefaultSwathWidthM is the sensor swath of search fleets that set no width.
+const DefaultSwathWidthM = 200.0
+
+// SearchWaypoints plans the route of one of lanes drones searching region
+// with a sensor swath of swath meters at altitude alt. Lawnmower splits the
+// region into lanes side by side, west to east, and sweeps each lane in legs
+// one swath apart. Expanding squares grow by a swath per drone and lap, each
+// drone offset by a swath so their tracks interleave. Polygon regions are
+// laid out over their extent, squares out of their anchor, and every leg is
+// clipped to the shape. Flown ping-pong, the route repeats the search
+// backwards.
+func SearchWaypoints(pattern string, region Region, lane, lanes int, swath, alt float64) []Position {
+	if lanes < 1 {
+		lanes = 1
+	}
+	if swath <= 0 {
+		swath = DefaultSwathWidthM
+	}
+	radius := region.RadiusKM * 1000
+	lat0, lon0 := region.CenterLat, region.CenterLon
+	if region.Shape != nil {
+		lat0, lon0 = region.Anchor()
+		radius = region.Shape.Reach(lat0, lon0)
+	}
+	at := func(east, north float64) Position {
+		lat, lon := geo.Offset(lat0, lon0, north, east)
+		return Position{Lat: lat, Lon: lon, Alt: alt}
+	}
+	var wps []Position
+	// leg appends the leg from a to b, or its stretches inside polygon regions
+	leg := func(a, b Position) {
+		parts := [][2]geo.Point{{{Lat: a.Lat, Lon: a.Lon}, {Lat: b.Lat, Lon: b.Lon}}}
+		if region.Shape != nil {
+			parts = region.Shape.Clip(parts[0][0], parts[0][1])
+		}
+		for _, part := range parts {
+			for _, pt := range part {
+				wp := Position{Lat: pt.Lat, Lon: pt.Lon, Alt: alt}
+				if n := len(wps); n == 0 || wps[n-1] != wp {
+					wps = append(wps, wp)
+				}
+			}
+		}
+	}
+
+	if pattern == SearchExpandingSquare {
+		step := swath * float64(lanes)
+		east, north := float64(lane)*swath, float64(lane)*swath
+		from := at(east, north)
+		dirs := [4][2]float64{{0, 1}, {1, 0}, {0, -1}, {-1, 0}} // north, east, south, west
+		for k := 0; float64(k/2)*step <= 2*radius; k++ {
+			length := float64(k/2+1) * step
+			east += dirs[k%4][0] * length
+			north += dirs[k%4][1] * length
+			to := at(east, north)
+			leg(from, to)
+			from = to
+		}
+		return orAnchor(wps, region, alt)
+	}
+
+	minEast, maxEast := -radius, radius
+	var minNorth, maxNorth float64
+	if region.Shape != nil {
+		minEast, maxEast, minNorth, maxNorth = extent(region.Shape, lat0, lon0)
+	}
+	width := (maxEast - minEast) / float64(lanes)
+	west := minEast + float64(lane)*width
+	legs := max(int(width/swath), 1)
+	spacing := width / float64(legs)
+	for k := 0; k < legs; k++ {
+		east := west + (float64(k)+0.5)*spacing
+		lo, hi := minNorth, maxNorth
+		if region.Shape == nil {
+			half := math.Sqrt(math.Max(0, radius*radius-east*east))
+			lo, hi = -half, half
+		}
+		if k%2 == 0 {
+			leg(at(east, lo), at(east, hi))
+		} else {
+			leg(at(east, hi), at(east, lo))
+		}
+	}
+	return orAnchor(wps, region, alt)
+}
+
+// extent returns the bounds in meters east and north of lat, lon of the
+// outer rings of shape.
+func extent(shape geo.MultiPolygon, lat, lon float64) (west, east, south, north float64) {
+	west, south = math.Inf(1), math.Inf(1)
+	east, north = math.Inf(-1), math.Inf(-1)
+	for _, p := range shape {
+		if len(p) == 0 {
+			continue
+		}
+		for _, pt := range p[0] {
+			x, y, _ := geo.ToENU(lat, lon, 0, pt.Lat, pt.Lon, 0)
+			west, east = math.Min(west, x), math.Max(east, x)
+			south, north = math.Min(south, y), math.Max(north, y)
+		}
+	}
+	return west, east, south, north
+}
+
+// orAnchor returns the waypoints, or the anchor of the region for lanes
+// that miss its polygons altogether.
+func orAnchor(wps []Position, region Region, alt float64) []Position {
+	if len(wps) > 0 {
+		return wps
+	}
+	lat, lon := region.Anchor()
+	return []Position{{Lat: lat, Lon: lon, Alt: alt}}
+}
@@ -0,0 +1,76 @@
+package telemetry
+
+import (
+	"math"
+	"testing"
+
+	"droneops-sim/internal/geo"
+)
+
+func TestLawnmowerSplitsRegionIntoLanes(t *testing.T) {
+	region := Region{CenterLat: 48.2, CenterLon: 16.4, RadiusKM: 1}
+	east := func(p Position) float64 {
+		return (p.Lon - region.CenterLon) * 111000 * math.Cos(region.CenterLat*math.Pi/180)
+	}
+	for lane := 0; lane < 2; lane++ {
+		wps := SearchWaypoints(SearchLawnmower, region, lane, 2, 250, 100)
+		if len(wps) != 8 {
+			t.Fatalf("lane %d: expected 4 legs of 2 waypoints, got %d", lane, len(wps))
+		}
+		for _, wp := range wps {
+			x := east(wp)
+			if x < float64(lane-1)*1000 || x > float64(lane)*1000 || wp.Alt != 100 {
+				t.Fatalf("lane %d: waypoint %+v outside its lane", lane, wp)
+			}
+		}
+		if wps[0].Lat > region.CenterLat || wps[1].Lat < region.CenterLat || wps[2].Lat < region.CenterLat {
+			t.Fatalf("lane %d: expected alternating north and south legs, got %+v", lane, wps[:3])
+		}
+	}
+}
+
+func TestExpandingSquareInterleavesDrones(t *testing.T) {
+	region := Region{CenterLat: 48.2, CenterLon: 16.4, RadiusKM: 1}
+	a := SearchWaypoints(SearchExpandingSquare, region, 0, 2, 100, 50)
+	b := SearchWaypoints(SearchExpandingSquare, region, 1, 2, 100, 50)
+	if a[0].Lat != region.CenterLat || a[0].Lon != region.CenterLon {
+		t.Fatalf("expected first drone to start at the center, got %+v", a[0])
+	}
+	if d := geo.Distance(a[1].Lat, a[1].Lon, a[0].Lat, a[0].Lon); math.Abs(d-200) > 1 {
+		t.Fatalf("expected first leg of two swaths, got %.1f m", d)
+	}
+	if d := geo.Distance(a[1].Lat, a[1].Lon, b[1].Lat, b[1].Lon); math.Abs(d-100*math.Sqrt2) > 1 {
+		t.Fatalf("expected second drone offset by a swath, got %.1f m", d)
+	}
+	last := a[len(a)-1]
+	if geo.Distance(last.Lat, last.Lon, region.CenterLat, region.CenterLon) < 1000 {
+		t.Fatalf("expected spiral to reach the edge of the region, got %+v", last)
+	}
+}
+
+// uRegion is a U open to the north, about 3 km across, centered on its
+// bounding box center in the notch.
+func uRegion() Region {
+	shape := geo.MultiPolygon{{geo.Ring{{Lat: 0, Lon: 0}, {Lat: 0, Lon: 0.03}, {Lat: 0.03, Lon: 0.03}, {Lat: 0.03, Lon: 0.02},
+		{Lat: 0.01, Lon: 0.02}, {Lat: 0.01, Lon: 0.01}, {Lat: 0.03, Lon: 0.01}, {Lat: 0.03, Lon: 0}}}}
+	lat, lon, radiusM := shape.BoundingCircle()
+	return Region{CenterLat: lat, CenterLon: lon, RadiusKM: radiusM / 1000, Shape: shape}
+}
+
+func TestSearchClipsLegsToPolygon(t *testing.T) {
+	region := uRegion()
+	shape := region.Shape
+	for _, pattern := range []string{SearchLawnmower, SearchExpandingSquare} {
+		for lane := 0; lane < 3; lane++ {
+			wps := SearchWaypoints(pattern, region, lane, 3, 200, 100)
+			if len(wps) < 2 {
+				t.Fatalf("%s lane %d: expected a route, got %+v", pattern, lane, wps)
+			}
+			for _, wp := range wps {
+				if !shape.Contains(wp.Lat, wp.Lon) && shape.DistanceToBoundary(wp.Lat, wp.Lon) > 1 {
+					t.Fatalf("%s lane %d: waypoint %+v outside the U", pattern, lane, wp)
+				}
+			}
+		}
+	}
+}
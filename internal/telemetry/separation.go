@@ -0,0 +1,57 @@
+package telemetry
+
+import (
+	"math"
+
+	"droneops-sim/internal/geo"
+)
+
+// Airborne reports whether the drone is in the air and has to keep its
+// separation from other drones.
+func (d *Drone) Airborne() bool {
+	return !d.Crashed && d.Phase != PhaseIdle && d.AGL() > 0
+}
+
+// Separation returns the distance two drones keep from each other, the larger
+// minimum separation of their models.
+func Separation(a, b *Drone) float64 {
+	return math.Max(ModelLimits(a.Model).MinSeparationM, ModelLimits(b.Model).MinSeparationM)
+}
+
+// Distance returns the distance between two drones in meters, including the
+// difference in altitude.
+func Distance(a, b *Drone) float64 {
+	return math.Hypot(geo.Distance(a.Position.Lat, a.Position.Lon, b.Position.Lat, b.Position.Lon), a.Position.Alt-b.Position.Alt)
+}
+
+// Separate nudges an airborne drone that just moved sideways away from the
+// airborne others it came closer to than their separation, by the shortfall.
+// Drones on the same spot split east and west by ID. Nudges keep above the
+// ground and are routed around restricted airspace like any other move.
+func Separate(drone *Drone, others []*Drone) {
+	if !drone.Airborne() {
+		return
+	}
+	for _, o := range others {
+		if o == drone || !o.Airborne() {
+			continue
+		}
+		dist := Distance(drone, o)
+		short := Separation(drone, o) - dist
+		if short <= 0 {
+			continue
+		}
+		heading := 90.0
+		if geo.Distance(o.Position.Lat, o.Position.Lon, drone.Position.Lat, drone.Position.Lon) > 0.01 {
+			heading = geo.Bearing(o.Position.Lat, o.Position.Lon, drone.Position.Lat, drone.Position.Lon)
+		} else if drone.ID < o.ID {
+			heading = 270
+		}
+		nudged := ahead(drone.Position, heading, short)
+		nudged.Alt = math.Max(nudged.Alt, drone.Ground(nudged))
+		if !drone.pursuing() {
+			nudged = avoid(drone, drone.Position, nudged)
+		}
+		drone.Position = nudged
+	}
+}
@@ -0,0 +1,76 @@
+package telemetry
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSeparateNudgesDronesApart(t *testing.T) {
+	pos := Position{Lat: 48.2, Lon: 16.4, Alt: 100}
+	a := &Drone{ID: "a", Model: "small-fpv", Position: pos}
+	b := &Drone{ID: "b", Model: "medium-uav", Position: pos}
+	drones := []*Drone{a, b}
+
+	Separate(a, drones)
+	if d := Distance(a, b); math.Abs(d-15) > 0.1 {
+		t.Fatalf("expected drones on the same spot to split to the larger separation of 15 m, got %.2f", d)
+	}
+	if a.Position.Lon >= b.Position.Lon {
+		t.Fatalf("expected lower ID to give way west, got %+v and %+v", a.Position, b.Position)
+	}
+}
+
+func TestSeparateIgnoresGroundedAndCrashedDrones(t *testing.T) {
+	pos := Position{Lat: 48.2, Lon: 16.4, Alt: 100}
+	a := &Drone{ID: "a", Model: "small-fpv", Position: pos}
+	idle := &Drone{ID: "b", Model: "small-fpv", Phase: PhaseIdle, Position: pos}
+	wreck := &Drone{ID: "c", Model: "small-fpv", Crashed: true, Position: pos}
+
+	Separate(a, []*Drone{a, idle, wreck})
+	if a.Position != pos {
+		t.Fatalf("expected no avoidance of drones out of the air, got %+v", a.Position)
+	}
+}
+
+func TestCrashedDroneStaysDown(t *testing.T) {
+	gen := NewGenerator("c", nil, nil)
+	drone := &Drone{
+		Model:    "small-fpv",
+		Phase:    PhaseOnStation,
+		Position: Position{Lat: 48.2, Lon: 16.4, Alt: 100},
+		Battery:  50,
+		Status:   StatusOK,
+		SpeedMPS: 20,
+		Crashed:  true,
+	}
+	row := gen.GenerateTelemetry(drone, drone.Position, 0)
+	if row.Alt != 0 || row.Status != StatusFailure || row.Battery != 50 || drone.OnTask() {
+		t.Fatalf("expected grounded failed wreck, got %+v", row)
+	}
+}
+
+// cliff rises to 150 m east of 16.41°E.
+type cliff struct{}
+
+func (cliff) Elevation(lat, lon float64) float64 {
+	if lon > 16.41 {
+		return 150
+	}
+	return 0
+}
+
+func TestSeparateKeepsNudgesClearOfAirspaceAndTerrain(t *testing.T) {
+	pos := Position{Lat: 48.2, Lon: 16.40995, Alt: 100} // A few meters west of the block and the cliff
+	a := &Drone{ID: "a", Model: "medium-uav", Position: pos}
+	b := &Drone{ID: "b", Model: "medium-uav", Position: pos, NoFly: []Airspace{block}}
+	Separate(b, []*Drone{a, b})
+	if block.Contains(b.Position) {
+		t.Fatalf("expected the nudge to stay out of the restricted area, got %+v", b.Position)
+	}
+
+	b.Position, b.NoFly, b.Terrain = pos, nil, cliff{}
+	Separate(b, []*Drone{a, b})
+	if b.Position.Lon <= 16.41 || b.AGL() < 0 {
+		t.Fatalf("expected the nudge east to clear the cliff, got %+v at %.1f m above ground", b.Position, b.AGL())
+	}
+}
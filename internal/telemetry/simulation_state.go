@@ -2,13 +2,25 @@ package telemetry
 
 import "time"
 
-// SimulationStateRow captures per-tick simulator state metrics.
+// SimulationStateRow captures per-tick simulator state metrics. Coverage
+// lists the search coverage of missions with search fleets.
 type SimulationStateRow struct {
-	ClusterID         string    `json:"cluster_id"`
-	CommunicationLoss float64   `json:"communication_loss"`
-	MessagesSent      int       `json:"messages_sent"`
-	SensorNoise       float64   `json:"sensor_noise"`
-	WeatherImpact     float64   `json:"weather_impact"`
-	ChaosMode         bool      `json:"chaos_mode"`
-	Timestamp         time.Time `json:"ts"`
+	ClusterID         string         `json:"cluster_id"`
+	CommunicationLoss float64        `json:"communication_loss"`
+	MessagesSent      int            `json:"messages_sent"`
+	SensorNoise       float64        `json:"sensor_noise"`
+	WeatherImpact     float64        `json:"weather_impact"`
+	WindDirectionDeg  float64        `json:"wind_direction_deg"`
+	WindSpeedMPS      float64        `json:"wind_speed_mps"`
+	ChaosMode         bool           `json:"chaos_mode"`
+	Coverage          []CoverageStat `json:"coverage,omitempty"`
+	Timestamp         time.Time      `json:"ts"`
+}
+
+// CoverageStat summarizes the search coverage of a mission.
+type CoverageStat struct {
+	MissionID    string  `json:"mission_id"`
+	CoveragePct  float64 `json:"coverage_pct"`   // Share of the region seen at least once
+	MeanRevisitS float64 `json:"mean_revisit_s"` // Mean time since seen cells were last seen
+	MaxRevisitS  float64 `json:"max_revisit_s"`  // Longest time since a seen cell was last seen
 }
@@ -6,13 +6,20 @@ const (
 	SwarmEventAssignment      = "assignment"
 	SwarmEventUnassignment    = "unassignment"
 	SwarmEventFormationChange = "formation_change"
+	SwarmEventNearMiss        = "near_miss"
+	SwarmEventCollision       = "collision"
 )
 
-// SwarmEventRow represents a swarm coordination event.
+// SwarmEventRow represents a swarm coordination event. Formation changes of
+// fleets flying a formation carry the formation type and leader, near misses
+// and collisions the distance between the two drones.
 type SwarmEventRow struct {
 	ClusterID string    `json:"cluster_id"`
 	EventType string    `json:"event_type"`
 	DroneIDs  []string  `json:"drone_ids"`
 	EnemyID   string    `json:"enemy_id,omitempty"`
+	Formation string    `json:"formation,omitempty"`
+	LeaderID  string    `json:"leader_id,omitempty"`
+	DistanceM float64   `json:"distance_m,omitempty"`
 	Timestamp time.Time `json:"ts"`
 }
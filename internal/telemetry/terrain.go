@@ -0,0 +1,55 @@
+package telemetry
+
+import (
+	"math"
+	"time"
+)
+
+// Altitude modes.
+const (
+	AltitudeMSL              = "msl"               // Fly the pattern's altitude above mean sea level
+	AltitudeTerrainFollowing = "terrain_following" // Hold the cruise altitude above the ground
+)
+
+// terrainLookaheadS is how far ahead, in seconds of flight, terrain-following
+// drones look for rising ground.
+const terrainLookaheadS = 10.0
+
+// Terrain gives the ground elevation in meters above mean sea level.
+type Terrain interface {
+	Elevation(lat, lon float64) float64
+}
+
+// Ground returns the elevation of the ground under pos, sea level without a
+// terrain model.
+func (d *Drone) Ground(pos Position) float64 {
+	if d.Terrain == nil {
+		return 0
+	}
+	return d.Terrain.Elevation(pos.Lat, pos.Lon)
+}
+
+// AGL returns the drone's height above the ground under it.
+func (d *Drone) AGL() float64 {
+	return d.Position.Alt - d.Ground(d.Position)
+}
+
+// followTerrain climbs or descends a terrain-following drone that moved from
+// from to pos toward its cruise altitude above the ground under it, or
+// under its track ahead where that rises higher, within its climb rate.
+func followTerrain(drone *Drone, from, pos Position, dt time.Duration) Position {
+	secs := dt.Seconds()
+	if drone.AltitudeMode != AltitudeTerrainFollowing || drone.Crashed || secs <= 0 {
+		return pos
+	}
+	switch drone.Phase {
+	case PhaseIdle, PhaseTakeoff, PhaseLanding:
+		return pos
+	}
+	ground := drone.Ground(pos)
+	lookahead := ahead(pos, drone.HeadingDeg, drone.SpeedMPS*terrainLookaheadS)
+	target := math.Max(ground, drone.Ground(lookahead)) + cruiseHeight(drone)
+	drone.ClimbRateMPS = clamp((target-from.Alt)/secs, ModelLimits(drone.Model).MaxClimbRateMPS)
+	pos.Alt = math.Max(ground, from.Alt+drone.ClimbRateMPS*secs)
+	return pos
+}
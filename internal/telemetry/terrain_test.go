@@ -0,0 +1,61 @@
+package telemetry
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+	"time"
+)
+
+// hill rises 50 m per kilometer east of 16.41°E up to 300 m.
+type hill struct{}
+
+func (hill) Elevation(lat, lon float64) float64 {
+	return math.Min(300, math.Max(0, (lon-16.41)*74000*0.05))
+}
+
+func TestTerrainFollowingHoldsHeightAboveGround(t *testing.T) {
+	gen := NewGenerator("c", rand.New(rand.NewSource(1)), nil)
+	start := Position{Lat: 48.2, Lon: 16.4, Alt: 100}
+	newDrone := func(mode string) *Drone {
+		return &Drone{
+			Model:           "large-uav",
+			MovementPattern: "point-to-point",
+			Position:        start,
+			Waypoints:       []Position{{Lat: 48.2, Lon: 16.5, Alt: 100}},
+			RouteMode:       "one-way",
+			HeadingDeg:      90,
+			SpeedMPS:        10,
+			Battery:         100,
+			CruiseAltM:      100,
+			AltitudeMode:    mode,
+			Terrain:         hill{},
+			Behavior:        Behavior{BatteryDrainRate: 0.1}, // Enough charge for ten minutes
+		}
+	}
+	follower, fixed := newDrone(AltitudeTerrainFollowing), newDrone(AltitudeMSL)
+	var row TelemetryRow
+	prev := start
+	for i := 0; i < 600; i++ {
+		row = gen.GenerateTelemetry(follower, prev, time.Second)
+		prev = follower.Position
+		if row.AltAGL < 30 {
+			t.Fatalf("tick %d: expected the drone to climb with the terrain, %.1f m above ground", i, row.AltAGL)
+		}
+	}
+	if math.Abs(row.AltAGL-100) > 5 || math.Abs(row.Alt-row.AltAGL-hill{}.Elevation(row.Lat, row.Lon)) > 1e-6 {
+		t.Fatalf("expected 100 m above the plateau, got alt %.1f agl %.1f", row.Alt, row.AltAGL)
+	}
+
+	prev = start
+	for i := 0; i < 600; i++ {
+		row = gen.GenerateTelemetry(fixed, prev, time.Second)
+		prev = fixed.Position
+		if row.AltAGL < 0 {
+			t.Fatalf("tick %d: expected the drone above the ground, got %.1f m", i, row.AltAGL)
+		}
+	}
+	if row.Alt < 300 || fixed.Airborne() {
+		t.Fatalf("expected a fixed altitude drone to scrape over the hill, got alt %.1f", row.Alt)
+	}
+}
@@ -4,6 +4,8 @@ package telemetry
 import (
 	"os"
 	"time"
+
+	"droneops-sim/internal/geo"
 )
 
 // MissionRow represents one mission record for telemetry.
@@ -22,13 +24,18 @@ type TelemetryRow struct {
 	MissionID        string    `json:"mission_id"`        // Added field for mission association
 	Lat              float64   `json:"lat"`               // FIELD
 	Lon              float64   `json:"lon"`               // FIELD
-	Alt              float64   `json:"alt"`               // FIELD
+	Alt              float64   `json:"alt"`               // FIELD altitude above mean sea level
+	AltAGL           float64   `json:"alt_agl"`           // FIELD altitude above the ground
 	Battery          float64   `json:"battery"`           // FIELD
 	Status           string    `json:"status"`            // FIELD
 	Follow           bool      `json:"follow"`            // FIELD indicates active follow mode
 	MovementPattern  string    `json:"movement_pattern"`  // FIELD movement pattern
-	SpeedMPS         float64   `json:"speed_mps"`         // FIELD speed in meters/second
+	SpeedMPS         float64   `json:"speed_mps"`         // FIELD ground speed in meters/second
+	AirspeedMPS      float64   `json:"airspeed_mps"`      // FIELD speed through the air in meters/second
 	HeadingDeg       float64   `json:"heading_deg"`       // FIELD heading in degrees
+	WaypointIndex    int       `json:"waypoint_index"`    // FIELD route waypoint the drone is heading to
+	Phase            string    `json:"phase"`             // FIELD flight lifecycle phase
+	ZoneBreach       bool      `json:"zone_breach"`       // FIELD set while inside restricted airspace
 	PreviousPosition Position  `json:"previous_position"` // FIELD previous position
 	SyncedFrom       string    `json:"synced_from"`       // Added by sync process
 	SyncedID         string    `json:"synced_id"`         // Added by sync process
@@ -52,19 +59,46 @@ func (TelemetryRow) TableName() string {
 
 // Drone holds runtime state for a simulated drone.
 type Drone struct {
-	ID                 string     // Drone ID
-	Model              string     // Drone model
-	MissionID          string     // Associated mission ID
-	Position           Position   // Current position
-	Battery            float64    // Battery level
-	Status             string     // Current status
-	MovementPattern    string     // Movement pattern: patrol, point-to-point, loiter
-	HomeRegion         Region     // Home region for patrol and loiter
-	Waypoints          []Position // Waypoints for point-to-point movement
-	FollowTarget       *Position  // If set, drone will move toward this target
-	SensorErrorRate    float64
-	DropoutRate        float64
-	BatteryAnomalyRate float64
+	ID              string     // Drone ID
+	Model           string     // Drone model
+	MissionID       string     // Associated mission ID
+	Position        Position   // Current position
+	Battery         float64    // Battery level
+	Status          string     // Current status
+	MovementPattern string     // Registered movement pattern, see RegisterPattern
+	PatternParams   Params     // Parameters of the movement pattern
+	HomeRegion      Region     // Home region for patrol and loiter
+	Waypoints       []Position // Route waypoints for point-to-point movement
+	RouteMode       string     // Route mode: loop, ping-pong, one-way
+	ArrivalRadiusM  float64    // Distance at which a waypoint counts as reached
+	WaypointIndex   int        // Index of the waypoint the drone is heading to
+	RouteReversed   bool       // Set while a ping-pong route is flown backwards
+	SpeedMPS        float64    // Current airspeed
+	HeadingDeg      float64    // Current heading in degrees from north
+	ClimbRateMPS    float64    // Current vertical speed, positive when climbing
+	Phase           string     // Flight lifecycle phase; empty flies the pattern without a lifecycle
+	Base            Position   // Launch and landing site
+	CruiseAltM      float64    // Altitude reached after takeoff, above the ground when terrain following
+	AltitudeMode    string     // Altitude mode, AltitudeMSL when empty
+	Terrain         Terrain    // Ground elevation model, sea level everywhere when nil
+	FollowTarget    *Position  // If set, drone will move toward this target
+	FormationSlot   *Position  // Formation position held by escort movement
+	Behavior        Behavior   // Speed, drain and failure tuning of the fleet
+	Wind            Wind       // Wind at the drone's position
+	NoFly           []Airspace // Restricted airspace in force, avoided by the movement patterns
+	Crashed         bool       // Set when the drone failed or was destroyed in a collision
+}
+
+// Behavior holds the tunable flight characteristics of a drone. Unset speeds
+// and drain fall back to the defaults of the drone model.
+type Behavior struct {
+	BatteryDrainRate   float64 // Battery percent consumed per second
+	FailureRate        float64 // Probability of a failure per second in the air
+	SpeedMinKmh        float64 // Lower bound of the cruise speed
+	SpeedMaxKmh        float64 // Upper bound of the cruise speed
+	SensorErrorRate    float64 // Probability of a position error per tick
+	DropoutRate        float64 // Probability of a lost telemetry row per tick
+	BatteryAnomalyRate float64 // Probability of a sudden battery drop per tick
 }
 
 // Position holds latitude, longitude, and altitude.
@@ -74,14 +108,62 @@ type Position struct {
 	Alt float64 // Altitude
 }
 
-// Region defines an operational region.
+// Region defines an operational region, a circle or the polygons of Shape.
+// Polygon regions carry their bounding circle in the center and radius.
 type Region struct {
-	Name      string  // Name of the region
-	CenterLat float64 // Latitude of the region center
-	CenterLon float64 // Longitude of the region center
-	RadiusKM  float64 // Radius of the region in kilometers
+	Name      string           // Name of the region
+	CenterLat float64          // Latitude of the region center
+	CenterLon float64          // Longitude of the region center
+	RadiusKM  float64          // Radius of the region in kilometers
+	Shape     geo.MultiPolygon // Boundary of polygon regions, nil for circles
 }
 
+// Flight lifecycle phases.
+const (
+	PhaseIdle         = "idle"           // On the ground at the base
+	PhaseTakeoff      = "takeoff"        // Climbing to cruise altitude above the base
+	PhaseTransit      = "transit"        // Flying from the base to the home region
+	PhaseOnStation    = "on_station"     // Flying the movement pattern
+	PhaseReturnToBase = "return_to_base" // Flying home to land
+	PhaseLanding      = "landing"        // Descending onto the base
+)
+
+// Lifecycle defaults.
+const (
+	DefaultCruiseAltM      = 100.0 // Cruise altitude when none is configured
+	LaunchBatteryThreshold = 90.0  // Idle drones are launched at or above this battery level
+	ReturnReserveMargin    = 1.5   // Safety factor on the battery needed to fly home
+)
+
+// OnTask reports whether the drone is available for mission tasks such as
+// following an enemy.
+func (d *Drone) OnTask() bool {
+	if d.Crashed {
+		return false
+	}
+	switch d.Phase {
+	case "", PhaseTransit, PhaseOnStation:
+		return true
+	}
+	return false
+}
+
+// pursuing reports whether the drone flies after its follow target, which
+// takes it through restricted airspace.
+func (d *Drone) pursuing() bool {
+	return d.FollowTarget != nil && d.OnTask()
+}
+
+// Route modes for point-to-point movement.
+const (
+	RouteLoop     = "loop"      // Return to the first waypoint after the last
+	RoutePingPong = "ping-pong" // Fly the route backwards after the last waypoint
+	RouteOneWay   = "one-way"   // Hold at the last waypoint
+)
+
+// DefaultArrivalRadiusM is used when a route sets no arrival radius.
+const DefaultArrivalRadiusM = 25.0
+
 // Drone status constants.
 const (
 	StatusOK         = "ok"
@@ -0,0 +1,42 @@
+package telemetry
+
+import (
+	"math"
+	"time"
+)
+
+// HeadwindDrainFactor is the extra battery drain per m/s of headwind, as a
+// fraction of the drone's normal drain rate.
+const HeadwindDrainFactor = 0.03
+
+// Wind is the wind acting on a drone.
+type Wind struct {
+	DirectionDeg float64 // Direction the wind blows from, in degrees from north
+	SpeedMPS     float64 // Wind speed including gusts
+}
+
+// drift moves pos downwind by the wind acting on the drone over dt. Drones on
+// the ground and in the vertical takeoff and landing legs hold their
+// position over the base.
+func drift(drone *Drone, pos Position, dt time.Duration) Position {
+	switch drone.Phase {
+	case PhaseIdle, PhaseTakeoff, PhaseLanding:
+		return pos
+	}
+	if drone.Wind.SpeedMPS <= 0 || pos.Alt <= drone.Ground(pos) {
+		return pos
+	}
+	return ahead(pos, math.Mod(drone.Wind.DirectionDeg+180, 360), drone.Wind.SpeedMPS*dt.Seconds())
+}
+
+// headwind returns the wind component against a drone flying on heading,
+// negative with a tailwind.
+func headwind(drone *Drone, heading float64) float64 {
+	return drone.Wind.SpeedMPS * math.Cos(angleDiff(drone.Wind.DirectionDeg, heading)*math.Pi/180)
+}
+
+// windDrain scales the battery drain of a drone flying on heading into a
+// headwind.
+func windDrain(drone *Drone, heading float64) float64 {
+	return 1 + HeadwindDrainFactor*math.Max(0, headwind(drone, heading))
+}
@@ -0,0 +1,52 @@
+package telemetry
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+	"time"
+)
+
+func TestWindPushesDroneOffTrack(t *testing.T) {
+	gen := NewGenerator("c", rand.New(rand.NewSource(1)), nil)
+	start := Position{Lat: 48.2, Lon: 16.4, Alt: 100}
+	east := Position{Lat: 48.2, Lon: 16.5, Alt: 100}
+	drone := &Drone{
+		Model:           "medium-uav",
+		MovementPattern: "point-to-point",
+		Position:        start,
+		Waypoints:       []Position{east},
+		HeadingDeg:      90,
+		SpeedMPS:        20,
+		Battery:         100,
+		Wind:            Wind{DirectionDeg: 0, SpeedMPS: 10},
+	}
+	row := gen.GenerateTelemetry(drone, start, time.Second)
+	if row.Lat >= start.Lat || row.AirspeedMPS != drone.SpeedMPS {
+		t.Fatalf("expected northerly wind to push the drone south, got %+v", row)
+	}
+	if math.Abs(row.SpeedMPS-math.Hypot(row.AirspeedMPS, 10)) > 0.5 {
+		t.Fatalf("expected ground speed to combine airspeed %.2f and crosswind, got %.2f", row.AirspeedMPS, row.SpeedMPS)
+	}
+}
+
+func TestHeadwindDrainsFaster(t *testing.T) {
+	drone := &Drone{HeadingDeg: 90, Wind: Wind{DirectionDeg: 90, SpeedMPS: 10}}
+	head := windDrain(drone, 90)
+	tail := windDrain(drone, 270)
+	drone.Wind = Wind{}
+	calm := windDrain(drone, 90)
+	if calm != 1 || tail != 1 || math.Abs(head-(1+10*HeadwindDrainFactor)) > 1e-9 {
+		t.Fatalf("expected only headwind to raise drain, got head %.2f tail %.2f calm %.2f", head, tail, calm)
+	}
+}
+
+func TestWindHoldsVerticalLegs(t *testing.T) {
+	pos := Position{Lat: 48.2, Lon: 16.4, Alt: 50}
+	for _, phase := range []string{PhaseIdle, PhaseTakeoff, PhaseLanding} {
+		drone := &Drone{Phase: phase, Wind: Wind{SpeedMPS: 10}}
+		if got := drift(drone, pos, time.Second); got != pos {
+			t.Fatalf("expected %s drone to hold position, got %+v", phase, got)
+		}
+	}
+}
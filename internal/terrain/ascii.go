@@ -0,0 +1,86 @@
+package terrain
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ParseASCII parses an ESRI ASCII grid: a header of ncols, nrows,
+// xllcorner or xllcenter, yllcorner or yllcenter, cellsize (or dx and dy)
+// and an optional nodata_value, followed by the heights from the northern
+// row down.
+func ParseASCII(data []byte) (*Grid, error) {
+	header := map[string]float64{}
+	var heights []float64
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	sc.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+	for sc.Scan() {
+		fields := strings.Fields(sc.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		key := strings.ToLower(fields[0])
+		if len(heights) == 0 && len(fields) == 2 && key[0] >= 'a' && key[0] <= 'z' {
+			v, err := strconv.ParseFloat(fields[1], 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid header %s: %w", fields[0], err)
+			}
+			header[key] = v
+			continue
+		}
+		for _, f := range fields {
+			v, err := strconv.ParseFloat(f, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid height %q", f)
+			}
+			heights = append(heights, v)
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, key := range []string{"ncols", "nrows"} {
+		if _, ok := header[key]; !ok {
+			return nil, fmt.Errorf("missing %s", key)
+		}
+	}
+	g := &Grid{Cols: int(header["ncols"]), Rows: int(header["nrows"]), Heights: heights}
+	g.CellLon, g.CellLat = header["cellsize"], header["cellsize"]
+	if dx, ok := header["dx"]; ok {
+		g.CellLon = dx
+	}
+	if dy, ok := header["dy"]; ok {
+		g.CellLat = dy
+	}
+	switch {
+	case has(header, "xllcenter") && has(header, "yllcenter"):
+		g.West = header["xllcenter"]
+		g.North = header["yllcenter"] + float64(g.Rows-1)*g.CellLat
+	case has(header, "xllcorner") && has(header, "yllcorner"):
+		g.West = header["xllcorner"] + g.CellLon/2
+		g.North = header["yllcorner"] + (float64(g.Rows)-0.5)*g.CellLat
+	default:
+		return nil, fmt.Errorf("missing xllcorner and yllcorner or xllcenter and yllcenter")
+	}
+	if err := g.validate(); err != nil {
+		return nil, err
+	}
+	if nodata, ok := header["nodata_value"]; ok {
+		for i, h := range g.Heights {
+			if h == nodata {
+				g.Heights[i] = math.NaN()
+			}
+		}
+	}
+	return g, nil
+}
+
+func has(header map[string]float64, key string) bool {
+	_, ok := header[key]
+	return ok
+}
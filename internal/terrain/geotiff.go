@@ -0,0 +1,353 @@
+package terrain
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// TIFF tags read from GeoTIFF elevation models.
+const (
+	tagImageWidth      = 256
+	tagImageLength     = 257
+	tagBitsPerSample   = 258
+	tagCompression     = 259
+	tagStripOffsets    = 273
+	tagSamplesPerPixel = 277
+	tagRowsPerStrip    = 278
+	tagStripByteCounts = 279
+	tagPredictor       = 317
+	tagTileWidth       = 322
+	tagTileLength      = 323
+	tagTileOffsets     = 324
+	tagTileByteCounts  = 325
+	tagSampleFormat    = 339
+	tagModelPixelScale = 33550
+	tagModelTiepoint   = 33922
+	tagGeoKeyDirectory = 34735
+	tagGDALNoData      = 42113
+)
+
+// GeoTIFF keys and their values.
+const (
+	keyModelType      = 1024
+	keyRasterType     = 1025
+	modelProjected    = 1
+	rasterPixelIsArea = 1
+)
+
+// ParseGeoTIFF parses a single-band GeoTIFF in geographic coordinates with
+// integer or floating point samples, uncompressed or deflated, in strips or
+// tiles. The GDAL nodata tag marks cells without data.
+func ParseGeoTIFF(data []byte) (*Grid, error) {
+	if len(data) < 8 {
+		return nil, fmt.Errorf("not a TIFF file")
+	}
+	var order binary.ByteOrder
+	switch string(data[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return nil, fmt.Errorf("not a TIFF file")
+	}
+	if order.Uint16(data[2:]) != 42 {
+		return nil, fmt.Errorf("only classic TIFF is supported")
+	}
+	t, err := readIFD(data, order, order.Uint32(data[4:]))
+	if err != nil {
+		return nil, err
+	}
+
+	w, h := int(t.num(tagImageWidth, 0)), int(t.num(tagImageLength, 0))
+	if spp := t.num(tagSamplesPerPixel, 1); spp != 1 {
+		return nil, fmt.Errorf("%v samples per pixel, need a single band", spp)
+	}
+	scale, tie := t.nums(tagModelPixelScale), t.nums(tagModelTiepoint)
+	if len(scale) < 2 || len(tie) < 6 {
+		return nil, fmt.Errorf("missing georeferencing")
+	}
+	raster := float64(rasterPixelIsArea)
+	keys := t.nums(tagGeoKeyDirectory)
+	for i := 4; i+3 < len(keys); i += 4 {
+		if keys[i+1] != 0 {
+			continue
+		}
+		switch keys[i] {
+		case keyModelType:
+			if keys[i+3] == modelProjected {
+				return nil, fmt.Errorf("projected coordinates are not supported, reproject to WGS 84")
+			}
+		case keyRasterType:
+			raster = keys[i+3]
+		}
+	}
+	g := &Grid{Cols: w, Rows: h, CellLon: scale[0], CellLat: scale[1], Heights: make([]float64, w*h)}
+	// The tie point maps a raster position to the model, the corner of a
+	// pixel unless pixels are points
+	i, j := tie[0], tie[1]
+	if raster == rasterPixelIsArea {
+		i, j = i-0.5, j-0.5
+	}
+	g.West, g.North = tie[3]-i*g.CellLon, tie[4]+j*g.CellLat
+	if err := g.validate(); err != nil {
+		return nil, err
+	}
+
+	d := decoder{
+		order:       order,
+		bits:        int(t.num(tagBitsPerSample, 1)),
+		format:      int(t.num(tagSampleFormat, 1)),
+		compression: int(t.num(tagCompression, 1)),
+		predictor:   int(t.num(tagPredictor, 1)),
+	}
+	cw, ch := w, int(t.num(tagRowsPerStrip, float64(h)))
+	offsets, counts := t.nums(tagStripOffsets), t.nums(tagStripByteCounts)
+	if _, tiled := t.tags[tagTileWidth]; tiled {
+		cw, ch = int(t.num(tagTileWidth, 0)), int(t.num(tagTileLength, 0))
+		offsets, counts = t.nums(tagTileOffsets), t.nums(tagTileByteCounts)
+	}
+	if cw < 1 || ch < 1 || len(offsets) != len(counts) {
+		return nil, fmt.Errorf("invalid strip or tile layout")
+	}
+	across := (w + cw - 1) / cw
+	if len(offsets) < across*((h+ch-1)/ch) {
+		return nil, fmt.Errorf("%d strips or tiles for a %dx%d image", len(offsets), w, h)
+	}
+	for k := range offsets {
+		x0, y0 := (k%across)*cw, (k/across)*ch
+		if y0 >= h {
+			break
+		}
+		off, n := int(offsets[k]), int(counts[k])
+		if off < 0 || n < 0 || off+n > len(data) {
+			return nil, fmt.Errorf("strip or tile %d out of bounds", k)
+		}
+		rows := ch
+		if cw == w {
+			rows = min(ch, h-y0) // The last strip may be short
+		}
+		samples, err := d.decode(data[off:off+n], cw, rows)
+		if err != nil {
+			return nil, err
+		}
+		for y := 0; y < rows && y0+y < h; y++ {
+			for x := 0; x < cw && x0+x < w; x++ {
+				g.Heights[(y0+y)*w+x0+x] = samples[y*cw+x]
+			}
+		}
+	}
+
+	if s := t.str(tagGDALNoData); s != "" {
+		nodata, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid nodata value %q", s)
+		}
+		for i, v := range g.Heights {
+			if v == nodata {
+				g.Heights[i] = math.NaN()
+			}
+		}
+	}
+	return g, nil
+}
+
+// ifd holds the entries of a TIFF image file directory.
+type ifd struct {
+	order binary.ByteOrder
+	tags  map[uint16]field
+}
+
+// field is the raw value of a directory entry.
+type field struct {
+	typ   uint16
+	count int
+	value []byte
+}
+
+// typeSize returns the size of a TIFF field type in bytes, 0 if unknown.
+func typeSize(typ uint16) int {
+	switch typ {
+	case 1, 2, 6, 7: // BYTE, ASCII, SBYTE, UNDEFINED
+		return 1
+	case 3, 8: // SHORT, SSHORT
+		return 2
+	case 4, 9, 11: // LONG, SLONG, FLOAT
+		return 4
+	case 5, 10, 12: // RATIONAL, SRATIONAL, DOUBLE
+		return 8
+	}
+	return 0
+}
+
+// readIFD reads the directory at off, resolving values stored elsewhere in
+// the file.
+func readIFD(data []byte, order binary.ByteOrder, off uint32) (*ifd, error) {
+	if int(off)+2 > len(data) {
+		return nil, fmt.Errorf("image directory out of bounds")
+	}
+	n := int(order.Uint16(data[off:]))
+	if int(off)+2+12*n > len(data) {
+		return nil, fmt.Errorf("image directory out of bounds")
+	}
+	t := &ifd{order: order, tags: make(map[uint16]field, n)}
+	for i := 0; i < n; i++ {
+		e := data[int(off)+2+12*i:]
+		tag, typ, count := order.Uint16(e), order.Uint16(e[2:]), int(order.Uint32(e[4:]))
+		size := typeSize(typ) * count
+		if size == 0 {
+			continue
+		}
+		value := e[8 : 8+min(size, 4)]
+		if size > 4 {
+			at := int(order.Uint32(e[8:]))
+			if at+size > len(data) || at < 0 {
+				return nil, fmt.Errorf("tag %d out of bounds", tag)
+			}
+			value = data[at : at+size]
+		}
+		t.tags[tag] = field{typ: typ, count: count, value: value[:size]}
+	}
+	return t, nil
+}
+
+// nums returns the values of a numeric tag.
+func (t *ifd) nums(tag uint16) []float64 {
+	f, ok := t.tags[tag]
+	if !ok {
+		return nil
+	}
+	out := make([]float64, f.count)
+	b, o := f.value, t.order
+	for i := range out {
+		switch f.typ {
+		case 1, 7:
+			out[i] = float64(b[i])
+		case 6:
+			out[i] = float64(int8(b[i]))
+		case 3:
+			out[i] = float64(o.Uint16(b[2*i:]))
+		case 8:
+			out[i] = float64(int16(o.Uint16(b[2*i:])))
+		case 4:
+			out[i] = float64(o.Uint32(b[4*i:]))
+		case 9:
+			out[i] = float64(int32(o.Uint32(b[4*i:])))
+		case 11:
+			out[i] = float64(math.Float32frombits(o.Uint32(b[4*i:])))
+		case 5:
+			out[i] = float64(o.Uint32(b[8*i:])) / float64(o.Uint32(b[8*i+4:]))
+		case 10:
+			out[i] = float64(int32(o.Uint32(b[8*i:]))) / float64(int32(o.Uint32(b[8*i+4:])))
+		case 12:
+			out[i] = math.Float64frombits(o.Uint64(b[8*i:]))
+		default:
+			return nil
+		}
+	}
+	return out
+}
+
+// num returns the first value of a numeric tag, def if it is not set.
+func (t *ifd) num(tag uint16, def float64) float64 {
+	if v := t.nums(tag); len(v) > 0 {
+		return v[0]
+	}
+	return def
+}
+
+// str returns the text of an ASCII tag.
+func (t *ifd) str(tag uint16) string {
+	f, ok := t.tags[tag]
+	if !ok || f.typ != 2 {
+		return ""
+	}
+	return strings.TrimSpace(strings.TrimRight(string(f.value), "\x00"))
+}
+
+// decoder turns the compressed bytes of a strip or tile into heights.
+type decoder struct {
+	order       binary.ByteOrder
+	bits        int
+	format      int
+	compression int
+	predictor   int
+}
+
+// decode returns the w*h samples of a strip or tile.
+func (d decoder) decode(chunk []byte, w, h int) ([]float64, error) {
+	switch d.compression {
+	case 1:
+	case 8, 32946: // Deflate
+		r, err := zlib.NewReader(bytes.NewReader(chunk))
+		if err != nil {
+			return nil, fmt.Errorf("deflate: %w", err)
+		}
+		if chunk, err = io.ReadAll(r); err != nil {
+			return nil, fmt.Errorf("deflate: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported compression %d", d.compression)
+	}
+	size := d.bits / 8
+	if d.bits%8 != 0 || size < 1 || size > 8 {
+		return nil, fmt.Errorf("unsupported %d-bit samples", d.bits)
+	}
+	if len(chunk) < w*h*size {
+		return nil, fmt.Errorf("truncated strip or tile")
+	}
+	switch d.predictor {
+	case 1:
+	case 2: // Horizontal differencing
+		if d.format == 3 {
+			return nil, fmt.Errorf("horizontal predictor on floating point samples")
+		}
+		for y := 0; y < h; y++ {
+			row := chunk[y*w*size : (y+1)*w*size]
+			for x := size; x < len(row); x += size {
+				switch size {
+				case 1:
+					row[x] += row[x-1]
+				case 2:
+					d.order.PutUint16(row[x:], d.order.Uint16(row[x:])+d.order.Uint16(row[x-2:]))
+				case 4:
+					d.order.PutUint32(row[x:], d.order.Uint32(row[x:])+d.order.Uint32(row[x-4:]))
+				}
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unsupported predictor %d", d.predictor)
+	}
+
+	out := make([]float64, w*h)
+	for i := range out {
+		b := chunk[i*size:]
+		switch {
+		case d.format == 1 && d.bits == 8:
+			out[i] = float64(b[0])
+		case d.format == 2 && d.bits == 8:
+			out[i] = float64(int8(b[0]))
+		case d.format == 1 && d.bits == 16:
+			out[i] = float64(d.order.Uint16(b))
+		case d.format == 2 && d.bits == 16:
+			out[i] = float64(int16(d.order.Uint16(b)))
+		case d.format == 1 && d.bits == 32:
+			out[i] = float64(d.order.Uint32(b))
+		case d.format == 2 && d.bits == 32:
+			out[i] = float64(int32(d.order.Uint32(b)))
+		case d.format == 3 && d.bits == 32:
+			out[i] = float64(math.Float32frombits(d.order.Uint32(b)))
+		case d.format == 3 && d.bits == 64:
+			out[i] = math.Float64frombits(d.order.Uint64(b))
+		default:
+			return nil, fmt.Errorf("unsupported %d-bit samples of format %d", d.bits, d.format)
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,176 @@
+package terrain
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"math"
+	"sort"
+	"testing"
+)
+
+// tiffTag is a directory entry for writeTIFF: SHORT, LONG or DOUBLE values,
+// or ASCII text.
+type tiffTag struct {
+	tag    uint16
+	shorts []uint16
+	longs  []uint32
+	floats []float64
+	text   string
+}
+
+// writeTIFF encodes a little-endian TIFF with the given strips or tiles
+// after the header and the directory at the end.
+func writeTIFF(chunks [][]byte, offsetsTag, countsTag uint16, tags []tiffTag) []byte {
+	var buf bytes.Buffer
+	buf.Write([]byte{'I', 'I', 42, 0, 0, 0, 0, 0})
+	var offsets, counts []uint32
+	for _, c := range chunks {
+		offsets = append(offsets, uint32(buf.Len()))
+		counts = append(counts, uint32(len(c)))
+		buf.Write(c)
+	}
+	tags = append(tags, tiffTag{tag: offsetsTag, longs: offsets}, tiffTag{tag: countsTag, longs: counts})
+	sort.Slice(tags, func(i, j int) bool { return tags[i].tag < tags[j].tag })
+
+	// Values longer than four bytes go before the directory
+	values := make([][]byte, len(tags))
+	types := make([]uint16, len(tags))
+	countsOf := make([]uint32, len(tags))
+	for i, t := range tags {
+		var v bytes.Buffer
+		switch {
+		case t.shorts != nil:
+			types[i], countsOf[i] = 3, uint32(len(t.shorts))
+			binary.Write(&v, binary.LittleEndian, t.shorts)
+		case t.longs != nil:
+			types[i], countsOf[i] = 4, uint32(len(t.longs))
+			binary.Write(&v, binary.LittleEndian, t.longs)
+		case t.floats != nil:
+			types[i], countsOf[i] = 12, uint32(len(t.floats))
+			binary.Write(&v, binary.LittleEndian, t.floats)
+		default:
+			types[i], countsOf[i] = 2, uint32(len(t.text)+1)
+			v.WriteString(t.text + "\x00")
+		}
+		values[i] = v.Bytes()
+	}
+	at := make([]uint32, len(tags))
+	for i, v := range values {
+		if len(v) > 4 {
+			at[i] = uint32(buf.Len())
+			buf.Write(v)
+		}
+	}
+	ifd := uint32(buf.Len())
+	binary.Write(&buf, binary.LittleEndian, uint16(len(tags)))
+	for i, t := range tags {
+		binary.Write(&buf, binary.LittleEndian, []uint16{t.tag, types[i]})
+		binary.Write(&buf, binary.LittleEndian, countsOf[i])
+		if len(values[i]) > 4 {
+			binary.Write(&buf, binary.LittleEndian, at[i])
+		} else {
+			var inline [4]byte
+			copy(inline[:], values[i])
+			buf.Write(inline[:])
+		}
+	}
+	buf.Write([]byte{0, 0, 0, 0})
+	out := buf.Bytes()
+	binary.LittleEndian.PutUint32(out[4:], ifd)
+	return out
+}
+
+// georef places the corner of the first pixel at 16°E 48.03°N with 0.01°
+// pixels in geographic coordinates.
+var georef = []tiffTag{
+	{tag: tagModelPixelScale, floats: []float64{0.01, 0.01, 0}},
+	{tag: tagModelTiepoint, floats: []float64{0, 0, 0, 16, 48.03, 0}},
+	{tag: tagGeoKeyDirectory, shorts: []uint16{1, 1, 0, 2, keyModelType, 0, 1, 2, keyRasterType, 0, 1, rasterPixelIsArea}},
+}
+
+func TestParseGeoTIFFStrips(t *testing.T) {
+	// Two strips of int16 heights, the second one short
+	heights := []int16{100, 200, 300, 400, 500, 600, -32768, 800, 900}
+	var raw bytes.Buffer
+	binary.Write(&raw, binary.LittleEndian, heights)
+	b := raw.Bytes()
+	tags := append([]tiffTag{
+		{tag: tagImageWidth, shorts: []uint16{3}},
+		{tag: tagImageLength, shorts: []uint16{3}},
+		{tag: tagBitsPerSample, shorts: []uint16{16}},
+		{tag: tagSampleFormat, shorts: []uint16{2}},
+		{tag: tagRowsPerStrip, shorts: []uint16{2}},
+		{tag: tagGDALNoData, text: "-32768"},
+	}, georef...)
+	g, err := ParseGeoTIFF(writeTIFF([][]byte{b[:12], b[12:]}, tagStripOffsets, tagStripByteCounts, tags))
+	if err != nil {
+		t.Fatalf("ParseGeoTIFF: %v", err)
+	}
+	if g.Cols != 3 || g.Rows != 3 || math.Abs(g.West-16.005) > 1e-9 || math.Abs(g.North-48.025) > 1e-9 {
+		t.Fatalf("unexpected grid %+v", g)
+	}
+	if h := g.Elevation(48.025, 16.005); h != 100 {
+		t.Fatalf("expected the north-west height, got %v", h)
+	}
+	if h := g.Elevation(48.015, 16.015); math.Abs(h-500) > 1e-6 {
+		t.Fatalf("expected the center height, got %v", h)
+	}
+	if h := g.Elevation(48.005, 16.005); math.Abs(h) > 1e-6 || !math.IsNaN(g.Heights[6]) {
+		t.Fatalf("expected no data in the south-west, got %v", h)
+	}
+}
+
+func TestParseGeoTIFFDeflatedTiles(t *testing.T) {
+	// A 3x3 float32 image in 2x2 tiles, deflated; pixels past the image
+	// edge are padding
+	tile := func(v ...float32) []byte {
+		var raw, z bytes.Buffer
+		binary.Write(&raw, binary.LittleEndian, v)
+		zw := zlib.NewWriter(&z)
+		zw.Write(raw.Bytes())
+		zw.Close()
+		return z.Bytes()
+	}
+	chunks := [][]byte{
+		tile(1, 2, 4, 5),
+		tile(3, -1, 6, -1),
+		tile(7, 8, -1, -1),
+		tile(9, -1, -1, -1),
+	}
+	tags := append([]tiffTag{
+		{tag: tagImageWidth, shorts: []uint16{3}},
+		{tag: tagImageLength, shorts: []uint16{3}},
+		{tag: tagBitsPerSample, shorts: []uint16{32}},
+		{tag: tagSampleFormat, shorts: []uint16{3}},
+		{tag: tagCompression, shorts: []uint16{8}},
+		{tag: tagTileWidth, shorts: []uint16{2}},
+		{tag: tagTileLength, shorts: []uint16{2}},
+	}, georef...)
+	g, err := ParseGeoTIFF(writeTIFF(chunks, tagTileOffsets, tagTileByteCounts, tags))
+	if err != nil {
+		t.Fatalf("ParseGeoTIFF: %v", err)
+	}
+	for i, want := range []float64{1, 2, 3, 4, 5, 6, 7, 8, 9} {
+		if g.Heights[i] != want {
+			t.Fatalf("expected heights 1 to 9, got %v", g.Heights)
+		}
+	}
+}
+
+func TestParseGeoTIFFRejectsProjected(t *testing.T) {
+	tags := []tiffTag{
+		{tag: tagImageWidth, shorts: []uint16{1}},
+		{tag: tagImageLength, shorts: []uint16{1}},
+		{tag: tagBitsPerSample, shorts: []uint16{8}},
+		{tag: tagModelPixelScale, floats: []float64{30, 30, 0}},
+		{tag: tagModelTiepoint, floats: []float64{0, 0, 0, 600000, 5300000, 0}},
+		{tag: tagGeoKeyDirectory, shorts: []uint16{1, 1, 0, 1, keyModelType, 0, 1, modelProjected}},
+	}
+	if _, err := ParseGeoTIFF(writeTIFF([][]byte{{42}}, tagStripOffsets, tagStripByteCounts, tags)); err == nil {
+		t.Fatalf("expected projected coordinates to be rejected")
+	}
+	if _, err := ParseGeoTIFF([]byte("ncols 1")); err == nil {
+		t.Fatalf("expected an error for a file that is not a TIFF")
+	}
+}
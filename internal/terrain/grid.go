@@ -0,0 +1,115 @@
+// Package terrain loads digital elevation models and answers ground height
+// and line-of-sight queries over them.
+package terrain
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"droneops-sim/internal/geo"
+)
+
+// maxSightSamples bounds the terrain samples taken along one line of sight.
+const maxSightSamples = 2000
+
+// Grid is an elevation model on a regular latitude/longitude grid in WGS 84.
+// Heights are in meters above mean sea level, row by row from the north.
+type Grid struct {
+	Cols, Rows int       // Size of the grid
+	West       float64   // Longitude of the center of the first column
+	North      float64   // Latitude of the center of the first row
+	CellLon    float64   // Column spacing in degrees
+	CellLat    float64   // Row spacing in degrees
+	Heights    []float64 // Elevation of every cell, NaN where there is no data
+}
+
+// Load reads an elevation model, an ESRI ASCII grid (.asc) or a GeoTIFF
+// (.tif, .tiff) in geographic coordinates.
+func Load(path string) (*Grid, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var g *Grid
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".asc", ".txt":
+		g, err = ParseASCII(data)
+	case ".tif", ".tiff":
+		g, err = ParseGeoTIFF(data)
+	default:
+		return nil, fmt.Errorf("%s: unsupported elevation format %q", path, ext)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return g, nil
+}
+
+// Elevation returns the ground height at lat, lon, interpolated between the
+// four surrounding cells. Cells without data and positions off the grid are
+// at sea level.
+func (g *Grid) Elevation(lat, lon float64) float64 {
+	x := geo.NormalizeLon(lon-g.West) / g.CellLon
+	y := (g.North - lat) / g.CellLat
+	if x < -0.5 || y < -0.5 || x > float64(g.Cols)-0.5 || y > float64(g.Rows)-0.5 {
+		return 0
+	}
+	x = math.Max(0, math.Min(x, float64(g.Cols-1)))
+	y = math.Max(0, math.Min(y, float64(g.Rows-1)))
+	c, r := min(int(x), g.Cols-2), min(int(y), g.Rows-2)
+	c, r = max(c, 0), max(r, 0)
+	fx, fy := x-float64(c), y-float64(r)
+	h00, h10 := g.at(c, r), g.at(c+1, r)
+	h01, h11 := g.at(c, r+1), g.at(c+1, r+1)
+	return (h00*(1-fx)+h10*fx)*(1-fy) + (h01*(1-fx)+h11*fx)*fy
+}
+
+// at returns the height of a cell, zero without data. Indices past a
+// single-cell edge are clamped.
+func (g *Grid) at(c, r int) float64 {
+	c, r = min(c, g.Cols-1), min(r, g.Rows-1)
+	h := g.Heights[r*g.Cols+c]
+	if math.IsNaN(h) {
+		return 0
+	}
+	return h
+}
+
+// LineOfSight reports whether the straight line between two points, given
+// with their altitudes above mean sea level, clears the terrain. The ground
+// is sampled every half cell and raised by the curvature of the earth.
+func (g *Grid) LineOfSight(lat1, lon1, alt1, lat2, lon2, alt2 float64) bool {
+	dist := geo.Distance(lat1, lon1, lat2, lon2)
+	step := g.CellLat * math.Pi / 180 * geo.EarthRadiusM / 2
+	n := min(int(math.Ceil(dist/step)), maxSightSamples)
+	if n < 2 {
+		return true
+	}
+	bearing := geo.Bearing(lat1, lon1, lat2, lon2)
+	for i := 1; i < n; i++ {
+		t := float64(i) / float64(n)
+		lat, lon := geo.Destination(lat1, lon1, bearing, t*dist)
+		bulge := t * (1 - t) * dist * dist / (2 * geo.EarthRadiusM)
+		if g.Elevation(lat, lon)+bulge > alt1+t*(alt2-alt1) {
+			return false
+		}
+	}
+	return true
+}
+
+// validate checks the grid size and spacing.
+func (g *Grid) validate() error {
+	if g.Cols < 1 || g.Rows < 1 {
+		return fmt.Errorf("empty grid %dx%d", g.Cols, g.Rows)
+	}
+	if len(g.Heights) != g.Cols*g.Rows {
+		return fmt.Errorf("%d heights for a %dx%d grid", len(g.Heights), g.Cols, g.Rows)
+	}
+	if g.CellLon <= 0 || g.CellLat <= 0 {
+		return fmt.Errorf("invalid cell size %vx%v", g.CellLon, g.CellLat)
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package terrain
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// ridge is a 5x3 grid, 0.01° cells, with a 500 m ridge along the middle
+// column and a cell without data in the south-west corner.
+const ridge = `ncols 5
+nrows 3
+xllcorner 16.0
+yllcorner 48.0
+cellsize 0.01
+NODATA_value -9999
+100 100 500 100 100
+100 100 500 100 100
+-9999 100 500 100 100
+`
+
+func TestParseASCII(t *testing.T) {
+	g, err := ParseASCII([]byte(ridge))
+	if err != nil {
+		t.Fatalf("ParseASCII: %v", err)
+	}
+	if g.Cols != 5 || g.Rows != 3 || math.Abs(g.West-16.005) > 1e-9 || math.Abs(g.North-48.025) > 1e-9 {
+		t.Fatalf("unexpected grid %+v", g)
+	}
+	for _, tc := range []struct {
+		lat, lon, want float64
+	}{
+		{48.025, 16.025, 500},  // Cell center on the ridge
+		{48.015, 16.02, 300},   // Halfway up the ridge
+		{48.005, 16.005, 0},    // No data
+		{48.015, 16.0475, 100}, // Within half a cell of the east edge
+		{48.1, 16.02, 0},       // Off the grid
+	} {
+		if got := g.Elevation(tc.lat, tc.lon); math.Abs(got-tc.want) > 1e-6 {
+			t.Errorf("Elevation(%v, %v) = %v, want %v", tc.lat, tc.lon, got, tc.want)
+		}
+	}
+
+	center := strings.Replace(strings.Replace(ridge, "xllcorner 16.0", "xllcenter 16.005", 1), "yllcorner 48.0", "yllcenter 48.005", 1)
+	if g2, err := ParseASCII([]byte(center)); err != nil || g2.West != g.West || math.Abs(g2.North-g.North) > 1e-9 {
+		t.Fatalf("expected cell center origin to match the corner origin, got %+v, %v", g2, err)
+	}
+	if _, err := ParseASCII([]byte(strings.Replace(ridge, "nrows 3", "nrows 4", 1))); err == nil {
+		t.Fatalf("expected an error for missing rows")
+	}
+}
+
+func TestLineOfSight(t *testing.T) {
+	g, err := ParseASCII([]byte(ridge))
+	if err != nil {
+		t.Fatalf("ParseASCII: %v", err)
+	}
+	if g.LineOfSight(48.015, 16.005, 150, 48.015, 16.045, 100) {
+		t.Fatalf("expected the ridge to block a low line of sight")
+	}
+	if !g.LineOfSight(48.015, 16.005, 1000, 48.015, 16.045, 100) {
+		t.Fatalf("expected a line of sight over the ridge")
+	}
+	if !g.LineOfSight(48.015, 16.045, 150, 48.025, 16.045, 100) {
+		t.Fatalf("expected a line of sight along the valley")
+	}
+	// Over 100 km of flat sea the curvature of the earth hides a boat
+	// from a drone at 100 m
+	flat := &Grid{Cols: 1, Rows: 1, West: 0, North: 0, CellLon: 0.01, CellLat: 0.01, Heights: []float64{math.NaN()}}
+	if flat.LineOfSight(0, 0, 100, 0, 0.9, 0) {
+		t.Fatalf("expected the horizon to block the line of sight")
+	}
+	if !flat.LineOfSight(0, 0, 100, 0, 0.1, 0) {
+		t.Fatalf("expected a line of sight within the horizon")
+	}
+}
+
+func TestLoadByExtension(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "dem.asc")
+	if err := os.WriteFile(path, []byte(ridge), 0644); err != nil {
+		t.Fatalf("failed to write grid: %v", err)
+	}
+	if _, err := Load(path); err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	other := filepath.Join(dir, "dem.png")
+	if err := os.WriteFile(other, []byte(ridge), 0644); err != nil {
+		t.Fatalf("failed to write grid: %v", err)
+	}
+	if _, err := Load(other); err == nil || !strings.Contains(err.Error(), "unsupported") {
+		t.Fatalf("expected an unsupported format error, got %v", err)
+	}
+}
@@ -0,0 +1,35 @@
+// Package weather models moving weather cells such as storms and fog banks
+// that degrade drone sensors and communications near them.
+package weather
+
+import "droneops-sim/internal/telemetry"
+
+// Type is the kind of weather cell.
+type Type string
+
+const (
+	// TypeStorm hampers communications more than sensors.
+	TypeStorm Type = "storm"
+	// TypeFog blinds sensors but barely affects communications.
+	TypeFog Type = "fog"
+)
+
+// Cell is a circular weather system of RadiusM around Position. Intensity
+// between 0 and 1 scales its effects, which fade toward the edge. The cell
+// drifts at DriftSpeedMPS toward DriftHeadingDeg.
+type Cell struct {
+	ID              string
+	Type            Type
+	Position        telemetry.Position
+	RadiusM         float64
+	Intensity       float64
+	DriftSpeedMPS   float64
+	DriftHeadingDeg float64
+}
+
+// Conditions are the local effects of the weather at a position, as
+// fractions between 0 and 1.
+type Conditions struct {
+	SensorImpact float64 // Loss of detection confidence
+	CommLoss     float64 // Probability that a command is lost
+}
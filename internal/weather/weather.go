@@ -0,0 +1,56 @@
+package weather
+
+import (
+	"time"
+
+	"droneops-sim/internal/geo"
+	"droneops-sim/internal/telemetry"
+)
+
+// Step drifts the cell for dt.
+func (c *Cell) Step(dt time.Duration) {
+	dist := c.DriftSpeedMPS * dt.Seconds()
+	if dist <= 0 {
+		return
+	}
+	c.Position.Lat, c.Position.Lon = geo.Destination(c.Position.Lat, c.Position.Lon, c.DriftHeadingDeg, dist)
+}
+
+// Strength returns the cell's intensity at pos, fading linearly from the
+// center to zero at the edge.
+func (c *Cell) Strength(pos telemetry.Position) float64 {
+	if c.RadiusM <= 0 {
+		return 0
+	}
+	d := geo.Distance(c.Position.Lat, c.Position.Lon, pos.Lat, pos.Lon)
+	if d >= c.RadiusM {
+		return 0
+	}
+	return c.Intensity * (1 - d/c.RadiusM)
+}
+
+// Effects returns the sensor and communication degradation of the cell at pos.
+func (c *Cell) Effects(pos telemetry.Position) Conditions {
+	s := c.Strength(pos)
+	switch c.Type {
+	case TypeFog:
+		return Conditions{SensorImpact: s, CommLoss: 0.1 * s}
+	default:
+		return Conditions{SensorImpact: 0.5 * s, CommLoss: 0.6 * s}
+	}
+}
+
+// At combines the global conditions with the effects of every cell at pos.
+// Overlapping effects compound, so the result stays below 1.
+func At(global Conditions, cells []*Cell, pos telemetry.Position) Conditions {
+	c := global
+	for _, cell := range cells {
+		e := cell.Effects(pos)
+		if e == (Conditions{}) {
+			continue
+		}
+		c.SensorImpact = 1 - (1-c.SensorImpact)*(1-e.SensorImpact)
+		c.CommLoss = 1 - (1-c.CommLoss)*(1-e.CommLoss)
+	}
+	return c
+}
@@ -0,0 +1,42 @@
+package weather
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"droneops-sim/internal/geo"
+	"droneops-sim/internal/telemetry"
+)
+
+func TestCellDriftsAndFades(t *testing.T) {
+	c := &Cell{Type: TypeStorm, Position: telemetry.Position{Lat: 48.2, Lon: 16.4}, RadiusM: 1000, Intensity: 0.8, DriftSpeedMPS: 10}
+	c.Step(100 * time.Second)
+	if math.Abs(geo.Distance(48.2, 16.4, c.Position.Lat, c.Position.Lon)-1000) > 1e-6 || c.Position.Lat < 48.2 || c.Position.Lon != 16.4 {
+		t.Fatalf("expected cell to drift 1 km north, got %+v", c.Position)
+	}
+	if s := c.Strength(c.Position); s != 0.8 {
+		t.Fatalf("expected full intensity at the center, got %f", s)
+	}
+	half := telemetry.Position{Lon: 16.4}
+	half.Lat, _ = geo.Destination(c.Position.Lat, c.Position.Lon, 0, 500)
+	if s := c.Strength(half); math.Abs(s-0.4) > 1e-9 {
+		t.Fatalf("expected half intensity halfway out, got %f", s)
+	}
+	if s := c.Strength(telemetry.Position{Lat: 48.3, Lon: 16.4}); s != 0 {
+		t.Fatalf("expected no effect outside the cell, got %f", s)
+	}
+}
+
+func TestConditionsCompound(t *testing.T) {
+	pos := telemetry.Position{Lat: 48.2, Lon: 16.4}
+	fog := &Cell{Type: TypeFog, Position: pos, RadiusM: 1000, Intensity: 0.5}
+	storm := &Cell{Type: TypeStorm, Position: pos, RadiusM: 1000, Intensity: 1}
+	c := At(Conditions{SensorImpact: 0.2, CommLoss: 0.1}, []*Cell{fog, storm}, pos)
+	if math.Abs(c.SensorImpact-(1-0.8*0.5*0.5)) > 1e-9 || math.Abs(c.CommLoss-(1-0.9*0.95*0.4)) > 1e-9 {
+		t.Fatalf("unexpected compounded conditions %+v", c)
+	}
+	if c := At(Conditions{CommLoss: 0.1}, []*Cell{storm}, telemetry.Position{Lat: 49}); c.CommLoss != 0.1 || c.SensorImpact != 0 {
+		t.Fatalf("expected global conditions far from any cell, got %+v", c)
+	}
+}
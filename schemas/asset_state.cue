@@ -0,0 +1,18 @@
+package schemas
+
+import "time"
+
+#AssetState: {
+        cluster_id: string
+        asset_id:   string
+        owner:      string
+        status:     "operational" | "damaged" | "destroyed"
+        lat:        number
+        lon:        number
+        alt:        number
+        radius_m:   number & >0
+        hit_points: number & >=0
+        health:     number & >=0 & <=100
+        attackers:  int & >=0
+        ts:         time.Time
+}
@@ -0,0 +1,17 @@
+package schemas
+
+import "time"
+
+#ConvoyState: {
+        cluster_id:  string
+        convoy_id:   string
+        status:      "en_route" | "arrived" | "destroyed"
+        lat:         number
+        lon:         number
+        alt:         number
+        speed_mps:   number & >=0
+        heading_deg: number
+        health:      number & >=0
+        waypoint:    int & >=0
+        ts:          time.Time
+}
@@ -0,0 +1,15 @@
+package schemas
+
+import "time"
+
+#GeofenceBreach: {
+        cluster_id:    string
+        drone_id:      string
+        zone_id:       string
+        event:         "entry" | "exit"
+        penetration_m: number & >=0
+        lat:           number
+        lon:           number
+        alt:           number
+        ts:            time.Time
+}
@@ -0,0 +1,21 @@
+package schemas
+
+import "time"
+
+#POIDetection: {
+        cluster_id:  string
+        drone_id:    string
+        poi_id:      string
+        poi_type:    "survivor" | "wreckage" | "cache"
+        status:      "missing" | "found" | "extracted"
+        lat:         number
+        lon:         number
+        alt:         number
+        drone_lat:   number
+        drone_lon:   number
+        drone_alt:   number
+        distance_m:  number
+        bearing_deg: number
+        confidence:  number & >=0 & <=100
+        ts:          time.Time
+}
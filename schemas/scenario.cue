@@ -0,0 +1,60 @@
+// CUE schema content for scenario files
+package schemas
+
+name?:        string
+description?: string
+
+phases: [_, ...] & [...{
+	name:         string & !=""
+	description?: string
+	enemy_objectives?: [...{
+		id:      string & !=""
+		action:  string & !=""
+		target?: string
+		route?: [...#Point]
+	}]
+	spawn?: {
+		enemies?: [...{
+			group?:       string
+			type:         string & !=""
+			count:        int & >=1
+			region?:      string
+			point?:       #Point
+			bearing?:     number & >=0 & <360
+			distance_km?: number & >=0
+			objective?: {
+				action:  string & !=""
+				target?: string
+				route?: [...#Point]
+			}
+		}]
+		fleets?: [...{
+			name?:    string
+			model:    string & !=""
+			count:    int & >=1
+			pattern?: string
+			mission?: string
+			region?:  string
+		}]
+	}
+	triggers?: [...{
+		event?: string & !=""
+		value?: int & >=0
+		all?: [...#Condition]
+		any?: [...#Condition]
+		next: string & !=""
+	}]
+}]
+
+#Condition: {
+	metric?: string & !=""
+	op?:     string
+	value?:  number
+	all?: [...#Condition]
+	any?: [...#Condition]
+}
+
+#Point: {
+	lat: number & >=-90 & <=90
+	lon: number & >=-180 & <=180
+}
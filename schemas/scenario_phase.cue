@@ -0,0 +1,13 @@
+package schemas
+
+import "time"
+
+#ScenarioPhase: {
+        cluster_id: string
+        scenario: string
+        phase: string
+        transition: "enter" | "exit"
+        event?: string
+        value: int
+        ts: time.Time
+}
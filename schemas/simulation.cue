@@ -1,33 +1,35 @@
 // CUE schema content for simulation.yaml
 package schemas
 
-zones: [...{
-	name:       string & !=""
-	center_lat: number
-	center_lon: number
-	radius_km:  number & >0
-}]
+zones: [...#Region]
 
 missions: [...{
 	id:          string & !=""
 	name:        string & !=""
 	objective:   string
 	description: string
-	region: {
-		name:       string & !=""
-		center_lat: number
-		center_lon: number
-		radius_km:  number & >0
-	}
+	region:      #Region
+	on_station?: int & >=0
 }]
 
 fleets: [...{
-	name:             string & !=""
-	model:            =~"small-fpv|medium-uav|large-uav"
-	count:            int & >0
-	movement_pattern: =~"patrol|point-to-point|loiter"
-	home_region:      string
-	mission_id:       string & !=""
+	name:                 string & !=""
+	model:                =~"small-fpv|medium-uav|large-uav"
+	count:                int & >0
+	movement_pattern:     #MovementPattern
+	pattern_params?:      #PatternParams[movement_pattern]
+	home_region:          string
+	mission_id:           string & !=""
+	escort?:              string
+	route?:               string & !=""
+	base?:                #Waypoint
+	base_station?:        string & !=""
+	cruise_alt_m?:        number & >0
+	altitude_mode?:       "msl" | "terrain_following"
+	formation?:           "line_abreast" | "column" | "wedge" | "box" | "ring"
+	formation_spacing_m?: number & >0
+	search_pattern?:      "lawnmower" | "expanding_square"
+	swath_width_m?:       number & >0
 	behavior?: {
 		battery_drain_rate?:   number & >=0
 		failure_rate?:         number & >=0 & <=1
@@ -39,9 +41,127 @@ fleets: [...{
 	}
 }]
 
+points_of_interest?: [...{
+	id:          string & !=""
+	type:        =~"survivor|wreckage|cache"
+	lat:         number
+	lon:         number
+	alt?:        number
+	difficulty?: number & >=0 & <=1
+}]
+
+routes?: [...{
+	name:              string & !=""
+	mode?:             "loop" | "ping-pong" | "one-way"
+	arrival_radius_m?: number & >0
+	waypoints: [#Waypoint, ...#Waypoint]
+}]
+
+convoys?: [...{
+	id:         string & !=""
+	speed_mps?: number & >0
+	health?:    number & >0
+	route: [#Waypoint, ...#Waypoint]
+}]
+
+fixed_assets?: [...{
+	id:          string & !=""
+	owner?:      string
+	lat:         number
+	lon:         number
+	alt?:        number
+	radius_m?:   number & >0
+	hit_points?: number & >0
+}]
+
+base_stations?: [...{
+	id:            string & !=""
+	lat:           number
+	lon:           number
+	alt?:          number
+	charge_slots?: int & >0
+	charge_rate?:  number & >0
+}]
+
+wind?: {
+	direction_deg?: number & >=0 & <360
+	speed_mps?:     number & >=0
+	gust_mps?:      number & >=0
+	veer_deg?:      number & >=0 & <=180
+	period_s?:      number & >0
+	regions?: [...{
+		region:         string & !=""
+		direction_deg?: number & >=0 & <360
+		speed_mps?:     number & >=0
+	}]
+}
+
+weather_cells?: [...{
+	id?:                string & !=""
+	type?:              "storm" | "fog"
+	lat:                number
+	lon:                number
+	radius_km?:         number & >0
+	intensity?:         number & >0 & <=1
+	drift_speed_mps?:   number & >=0
+	drift_heading_deg?: number & >=0 & <360
+}]
+
+collisions?: {
+	radius_m?:    number & >0
+	fail_drones?: bool
+}
+
+// Restricted areas are no-fly zones between the floor and ceiling, heights
+// above the ground, active between the given seconds after the start; zero
+// means from the ground, without ceiling and until the end.
+restricted_areas?: [...{
+	id:              string & !=""
+	geometry?:       #Geometry
+	geojson?:        string & !=""
+	floor_m?:        number & >=0
+	ceiling_m?:      number & >=0
+	active_from_s?:  number & >=0
+	active_until_s?: number & >=0
+}]
+
+// Digital elevation model, an ESRI ASCII grid (.asc) or a GeoTIFF (.tif)
+// in geographic coordinates, relative to this config.
+terrain?: {
+	dem: string & !=""
+}
+
+// A region is a circle, or the polygons of an inline GeoJSON geometry or of a
+// GeoJSON file relative to this config; polygons default the center and
+// radius to their bounding circle.
+#Region: {
+	name:        string & !=""
+	center_lat?: number
+	center_lon?: number
+	radius_km?:  number & >0
+	geometry?:   #Geometry
+	geojson?:    string & !=""
+}
+
+#Geometry: {
+	type: "Polygon" | "MultiPolygon" | "GeometryCollection" | "Feature" | "FeatureCollection"
+	...
+}
+
+#Waypoint: {
+	lat:  number
+	lon:  number
+	alt?: number
+}
+
 follow_confidence?: number & >=0 & <=100
 
-swarm_responses?: {[=~"patrol|point-to-point|loiter"]: int}
+swarm_responses?: close({[#MovementPattern]: int})
+
+// The registered movement patterns complete these definitions on validation,
+// see telemetry.PatternSchema.
+#MovementPattern: string
+#PatternParams: {...}
 
 mission_criticality?: =~"low|medium|high"
 
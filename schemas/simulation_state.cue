@@ -8,7 +8,15 @@ import "time"
         messages_sent: int
         sensor_noise: number
         weather_impact: number
+        wind_direction_deg: number & >=0 & <360
+        wind_speed_mps: number & >=0
         chaos_mode: bool
+        coverage?: [...{
+                mission_id: string
+                coverage_pct: number & >=0 & <=100
+                mean_revisit_s: number & >=0
+                max_revisit_s: number & >=0
+        }]
         ts: time.Time
 }
 
@@ -7,6 +7,9 @@ import "time"
         event_type: string
         drone_ids: [...string]
         enemy_id?: string
+        formation?: "line_abreast" | "column" | "wedge" | "box" | "ring"
+        leader_id?: string
+        distance_m?: number & >=0
         ts: time.Time
 }
 
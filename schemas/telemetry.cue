@@ -9,12 +9,17 @@ import "time"
         lat: number
         lon: number
         alt: number
+        alt_agl: number
         battery: number
         status: string
         follow: bool
         movement_pattern: string
         speed_mps: number
+        airspeed_mps: number & >=0
         heading_deg: number
+        waypoint_index: int & >=0
+        phase: "idle" | "takeoff" | "transit" | "on_station" | "return_to_base" | "landing"
+        zone_breach: bool
         previous_position: {
                 lat: number
                 lon: number